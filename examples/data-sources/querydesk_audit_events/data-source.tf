@@ -0,0 +1,15 @@
+data "querydesk_audit_events" "production_q3" {
+  database_id = querydesk_database.production.id
+  since       = "2024-07-01T00:00:00Z"
+  until       = "2024-10-01T00:00:00Z"
+}
+
+check "no_unreviewed_writes" {
+  assert {
+    condition = alltrue([
+      for event in data.querydesk_audit_events.production_q3.events :
+      contains(["APPROVED", "NOT_REQUIRED"], event.approval_status)
+    ])
+    error_message = "Some production queries were not approved."
+  }
+}
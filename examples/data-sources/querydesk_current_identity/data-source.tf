@@ -0,0 +1,8 @@
+data "querydesk_current_identity" "current" {}
+
+check "scoped_api_key" {
+  assert {
+    condition     = length(data.querydesk_current_identity.current.database_ids) > 0
+    error_message = "This pipeline should run with an API key limited to its databases."
+  }
+}
@@ -0,0 +1,6 @@
+ephemeral "querydesk_database_user" "migrator" {
+  database_id = querydesk_database.example.id
+  username    = "migrator"
+  password    = var.migrator_password
+  ttl         = "15m"
+}
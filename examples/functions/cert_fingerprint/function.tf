@@ -0,0 +1,3 @@
+output "ca_fingerprint" {
+  value = provider::querydesk::cert_fingerprint(file("${path.module}/ca.pem"))
+}
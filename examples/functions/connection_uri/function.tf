@@ -0,0 +1,4 @@
+output "migration_dsn" {
+  value     = provider::querydesk::connection_uri(querydesk_database.example, "migrator", var.migrator_password)
+  sensitive = true
+}
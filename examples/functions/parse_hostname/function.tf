@@ -0,0 +1,11 @@
+locals {
+  primary = provider::querydesk::parse_hostname(var.primary_endpoint)
+}
+
+resource "querydesk_database" "example" {
+  name     = "primary"
+  adapter  = "POSTGRES"
+  hostname = local.primary.hostname
+  port     = local.primary.port
+  database = "mydb"
+}
@@ -0,0 +1,11 @@
+resource "time_rotating" "ci" {
+  rotation_days = 90
+}
+
+resource "querydesk_api_key" "ci" {
+  name             = "ci-analytics"
+  read_only        = true
+  database_ids     = [querydesk_database.example.id]
+  expires_at       = "2030-01-01T00:00:00Z"
+  rotation_trigger = time_rotating.ci.id
+}
@@ -3,4 +3,12 @@ resource "querydesk_database" "example" {
   adapter  = "POSTGRES"
   hostname = "localhost"
   database = "mydb"
+  ssl      = true
+
+  deletion_protection = true
+
+  postgres {
+    sslmode     = "verify-full"
+    search_path = "app, public"
+  }
 }
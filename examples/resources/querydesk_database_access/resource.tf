@@ -0,0 +1,20 @@
+resource "querydesk_database" "example" {
+  name     = "terraform_test"
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+}
+
+resource "querydesk_database_user" "readonly" {
+  database_id      = querydesk_database.example.id
+  username         = "readonly"
+  password         = "readonly"
+  reviews_required = 0
+}
+
+# Let the analytics team use the readonly user only.
+resource "querydesk_database_access" "analytics" {
+  database_id    = querydesk_database.example.id
+  team_id        = "team_12345"
+  credential_ids = [querydesk_database_user.readonly.id]
+}
@@ -0,0 +1,5 @@
+resource "querydesk_review_policy" "example" {
+  name             = "production"
+  reviewer_team_id = querydesk_team.example.id
+  read_only_bypass = true
+}
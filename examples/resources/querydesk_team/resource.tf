@@ -0,0 +1,4 @@
+resource "querydesk_team" "example" {
+  name        = "platform"
+  description = "Platform engineers"
+}
@@ -0,0 +1,13 @@
+resource "querydesk_user" "example" {
+  email = "jane@example.com"
+  name  = "Jane Doe"
+}
+
+resource "querydesk_team" "example" {
+  name = "platform"
+}
+
+resource "querydesk_team_membership" "example" {
+  team_id = querydesk_team.example.id
+  user_id = querydesk_user.example.id
+}
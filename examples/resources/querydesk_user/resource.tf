@@ -0,0 +1,5 @@
+resource "querydesk_user" "example" {
+  email = "jane@example.com"
+  name  = "Jane Doe"
+  role  = "USER"
+}
@@ -3,37 +3,196 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+type requestIDKey struct{}
+
+// RequestID returns the id sent in the X-Request-Id header of the API call
+// made with ctx, if any.
+func RequestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
 type authedTransport struct {
-	key     string
-	wrapped http.RoundTripper
+	key       string
+	userAgent string
+	wrapped   http.RoundTripper
 }
 
 func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set("x-api-key", t.key)
+
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+
+	if id, ok := RequestID(req.Context()); ok {
+		req.Header.Set("X-Request-Id", id)
+	}
+
 	return t.wrapped.RoundTrip(req)
 }
 
-func NewClient(host *string, apiKey *string) (*graphql.Client, error) {
+// requestIDClient tags every API call with a unique request id so that it can
+// be correlated with the QueryDesk access logs.
+type requestIDClient struct {
+	wrapped graphql.Client
+}
+
+func (c *requestIDClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating request id: %w", err)
+	}
+
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+
+	tflog.Debug(ctx, "Calling QueryDesk API", map[string]interface{}{
+		"operation":  req.OpName,
+		"request_id": id,
+	})
+
+	err = c.wrapped.MakeRequest(ctx, req, resp)
+	if err != nil {
+		tflog.Debug(ctx, "QueryDesk API call failed", map[string]interface{}{
+			"operation":  req.OpName,
+			"request_id": id,
+			"error":      err.Error(),
+		})
+
+		return fmt.Errorf("%w (request id: %s)", err, id)
+	}
+
+	return nil
+}
+
+// ClientOptions customizes how connections to the QueryDesk API are made.
+// The zero value behaves like http.DefaultClient.
+type ClientOptions struct {
+	// CACertPEM is a PEM encoded bundle of certificate authorities to trust
+	// in addition to the system pool.
+	CACertPEM string
+	// CACertFile is a path to a PEM encoded bundle, used the same way as CACertPEM.
+	CACertFile string
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+	// ClientCertPEM and ClientKeyPEM are used for mutual TLS with the API.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// ProxyURL overrides the proxy taken from the HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY environment variables.
+	ProxyURL string
+	// UserAgent is sent with every request when set.
+	UserAgent string
+	// RequestTimeout limits how long a single API request may take, zero
+	// means no limit.
+	RequestTimeout time.Duration
+}
+
+func newTransport(opts ClientOptions) (*http.Transport, error) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("http.DefaultTransport is not an *http.Transport")
+	}
+
+	transport := defaultTransport.Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	caCertPEM := []byte(opts.CACertPEM)
+	if opts.CACertFile != "" {
+		contents, err := os.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading ca cert file: %w", err)
+		}
+		caCertPEM = append(caCertPEM, contents...)
+	}
+
+	if len(caCertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCertPEM) {
+			return nil, errors.New("no valid certificates found in ca cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCertPEM != "" || opts.ClientKeyPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(opts.ClientCertPEM), []byte(opts.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy url: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("parsing proxy url: %q must include a scheme and host", opts.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+func NewClient(host *string, apiKey *string, opts ClientOptions) (*graphql.Client, error) {
+	transport, err := newTransport(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	httpClient := http.Client{
+		Timeout: opts.RequestTimeout,
 		Transport: &authedTransport{
-			key:     *apiKey,
-			wrapped: http.DefaultTransport,
+			key:       *apiKey,
+			userAgent: opts.UserAgent,
+			wrapped:   transport,
 		},
 	}
 
-	c := graphql.NewClient(fmt.Sprintf("%s/graphql", *host), &httpClient)
+	var c graphql.Client = &requestIDClient{
+		wrapped: graphql.NewClient(fmt.Sprintf("%s/graphql", *host), &checkedDoer{wrapped: &httpClient}),
+	}
 
 	return &c, nil
 }
 
 type GetDatabaseResponse = getDatabaseResponse
 type GetDatabaseDatabase = getDatabaseDatabase
+type GetDatabaseDatabasePostgresOptions = getDatabaseDatabasePostgresOptions
+type GetDatabaseDatabaseMysqlOptions = getDatabaseDatabaseMysqlOptions
+type GetDatabaseDatabaseSqlserverOptions = getDatabaseDatabaseSqlserverOptions
+
+type ListDatabasesResponse = listDatabasesResponse
+type ListDatabasesListDatabasesDatabase = listDatabasesListDatabasesDatabase
+type ListDatabasesListDatabasesDatabasePostgresOptions = listDatabasesListDatabasesDatabasePostgresOptions
+type ListDatabasesListDatabasesDatabaseMysqlOptions = listDatabasesListDatabasesDatabaseMysqlOptions
+type ListDatabasesListDatabasesDatabaseSqlserverOptions = listDatabasesListDatabasesDatabaseSqlserverOptions
+type ListDatabasesListDatabasesDatabaseCredentialsCredential = listDatabasesListDatabasesDatabaseCredentialsCredential
 
 type CreateDatabaseResponse = createDatabaseResponse
 type CreateDatabaseCreateDatabaseCreateDatabaseResult = createDatabaseCreateDatabaseCreateDatabaseResult
@@ -47,9 +206,23 @@ type DeleteDatabaseResponse = deleteDatabaseResponse
 type DeleteDatabaseDeleteDatabaseDeleteDatabaseResult = deleteDatabaseDeleteDatabaseDeleteDatabaseResult
 type DeleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase = deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase
 
+type GetDatabaseCredentialsResponse = getDatabaseCredentialsResponse
+type GetDatabaseCredentialsDatabase = getDatabaseCredentialsDatabase
+type GetDatabaseCredentialsDatabaseCredentialsCredential = getDatabaseCredentialsDatabaseCredentialsCredential
+
+type FindDatabasesByNameResponse = findDatabasesByNameResponse
+type FindDatabasesByNameListDatabasesDatabase = findDatabasesByNameListDatabasesDatabase
+
+type FindCredentialsByUsernameResponse = findCredentialsByUsernameResponse
+type FindCredentialsByUsernameDatabase = findCredentialsByUsernameDatabase
+type FindCredentialsByUsernameDatabaseCredentialsCredential = findCredentialsByUsernameDatabaseCredentialsCredential
+
 type GetCredentialResponse = getCredentialResponse
 type GetCredentialCredential = getCredentialCredential
 type GetCredentialCredentialDatabase = getCredentialCredentialDatabase
+type GetCredentialCredentialDatabasePostgresOptions = getCredentialCredentialDatabasePostgresOptions
+type GetCredentialCredentialDatabaseMysqlOptions = getCredentialCredentialDatabaseMysqlOptions
+type GetCredentialCredentialDatabaseSqlserverOptions = getCredentialCredentialDatabaseSqlserverOptions
 
 type CreateCredentialResponse = createCredentialResponse
 type CreateCredentialCreateCredentialCreateCredentialResult = createCredentialCreateCredentialCreateCredentialResult
@@ -63,16 +236,145 @@ type DeleteCredentialResponse = deleteCredentialResponse
 type DeleteCredentialDeleteCredentialDeleteCredentialResult = deleteCredentialDeleteCredentialDeleteCredentialResult
 type DeleteCredentialDeleteCredentialDeleteCredentialResultResultCredential = deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential
 
+type GetDatabaseAccessResponse = getDatabaseAccessResponse
+type GetDatabaseAccessDatabaseAccess = getDatabaseAccessDatabaseAccess
+
+type CreateDatabaseAccessResponse = createDatabaseAccessResponse
+type CreateDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult = createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult
+type CreateDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess = createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess
+
+type UpdateDatabaseAccessResponse = updateDatabaseAccessResponse
+type UpdateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult = updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult
+type UpdateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess = updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess
+
+type DeleteDatabaseAccessResponse = deleteDatabaseAccessResponse
+type DeleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult = deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult
+type DeleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess = deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess
+
+type GetReviewPolicyResponse = getReviewPolicyResponse
+type GetReviewPolicyReviewPolicy = getReviewPolicyReviewPolicy
+
+type CreateReviewPolicyResponse = createReviewPolicyResponse
+type CreateReviewPolicyCreateReviewPolicyCreateReviewPolicyResult = createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult
+type CreateReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy = createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy
+
+type UpdateReviewPolicyResponse = updateReviewPolicyResponse
+type UpdateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult = updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult
+type UpdateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy = updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy
+
+type DeleteReviewPolicyResponse = deleteReviewPolicyResponse
+type DeleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult = deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult
+type DeleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy = deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy
+
+type GetUserResponse = getUserResponse
+type GetUserUser = getUserUser
+
+type CreateUserResponse = createUserResponse
+type CreateUserCreateUserCreateUserResult = createUserCreateUserCreateUserResult
+type CreateUserCreateUserCreateUserResultResultUser = createUserCreateUserCreateUserResultResultUser
+
+type UpdateUserResponse = updateUserResponse
+type UpdateUserUpdateUserUpdateUserResult = updateUserUpdateUserUpdateUserResult
+type UpdateUserUpdateUserUpdateUserResultResultUser = updateUserUpdateUserUpdateUserResultResultUser
+
+type DeleteUserResponse = deleteUserResponse
+type DeleteUserDeleteUserDeleteUserResult = deleteUserDeleteUserDeleteUserResult
+type DeleteUserDeleteUserDeleteUserResultResultUser = deleteUserDeleteUserDeleteUserResultResultUser
+
+type GetTeamResponse = getTeamResponse
+type GetTeamTeam = getTeamTeam
+
+type CreateTeamResponse = createTeamResponse
+type CreateTeamCreateTeamCreateTeamResult = createTeamCreateTeamCreateTeamResult
+type CreateTeamCreateTeamCreateTeamResultResultTeam = createTeamCreateTeamCreateTeamResultResultTeam
+
+type UpdateTeamResponse = updateTeamResponse
+type UpdateTeamUpdateTeamUpdateTeamResult = updateTeamUpdateTeamUpdateTeamResult
+type UpdateTeamUpdateTeamUpdateTeamResultResultTeam = updateTeamUpdateTeamUpdateTeamResultResultTeam
+
+type DeleteTeamResponse = deleteTeamResponse
+type DeleteTeamDeleteTeamDeleteTeamResult = deleteTeamDeleteTeamDeleteTeamResult
+type DeleteTeamDeleteTeamDeleteTeamResultResultTeam = deleteTeamDeleteTeamDeleteTeamResultResultTeam
+
+type GetTeamMembershipResponse = getTeamMembershipResponse
+type GetTeamMembershipTeamMembership = getTeamMembershipTeamMembership
+
+type CreateTeamMembershipResponse = createTeamMembershipResponse
+type CreateTeamMembershipCreateTeamMembershipCreateTeamMembershipResult = createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult
+type CreateTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership = createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership
+
+type DeleteTeamMembershipResponse = deleteTeamMembershipResponse
+type DeleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult = deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult
+type DeleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership = deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership
+
+type GetApiKeyResponse = getApiKeyResponse
+type GetApiKeyApiKey = getApiKeyApiKey
+
+type GetCurrentApiKeyResponse = getCurrentApiKeyResponse
+type GetCurrentApiKeyCurrentApiKey = getCurrentApiKeyCurrentApiKey
+
+type CreateApiKeyResponse = createApiKeyResponse
+type CreateApiKeyCreateApiKeyCreateApiKeyResult = createApiKeyCreateApiKeyCreateApiKeyResult
+type CreateApiKeyCreateApiKeyCreateApiKeyResultResultApiKey = createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey
+
+type UpdateApiKeyResponse = updateApiKeyResponse
+type UpdateApiKeyUpdateApiKeyUpdateApiKeyResult = updateApiKeyUpdateApiKeyUpdateApiKeyResult
+type UpdateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey = updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey
+
+type RotateApiKeyResponse = rotateApiKeyResponse
+type RotateApiKeyRotateApiKeyRotateApiKeyResult = rotateApiKeyRotateApiKeyRotateApiKeyResult
+type RotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey = rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey
+
+type DeleteApiKeyResponse = deleteApiKeyResponse
+type DeleteApiKeyDeleteApiKeyDeleteApiKeyResult = deleteApiKeyDeleteApiKeyDeleteApiKeyResult
+type DeleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey = deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey
+
+type ListAuditEventsResponse = listAuditEventsResponse
+type ListAuditEventsListAuditEventsAuditEvent = listAuditEventsListAuditEventsAuditEvent
+
+type PingResponse = pingResponse
+
 //go:generate go run github.com/vektra/mockery/v2 --name GraphQLClient
 type GraphQLClient interface {
 	GetDatabase(ctx context.Context, id string) (*GetDatabaseResponse, error)
+	ListDatabases(ctx context.Context, limit int, offset int) (*ListDatabasesResponse, error)
 	CreateDatabase(ctx context.Context, input CreateDatabaseInput) (*CreateDatabaseResponse, error)
 	UpdateDatabase(ctx context.Context, id string, input UpdateDatabaseInput) (*UpdateDatabaseResponse, error)
+	GetDatabaseCredentials(ctx context.Context, id string) (*GetDatabaseCredentialsResponse, error)
+	FindDatabasesByName(ctx context.Context, name string) (*FindDatabasesByNameResponse, error)
+	FindCredentialsByUsername(ctx context.Context, databaseId string, username string) (*FindCredentialsByUsernameResponse, error)
 	DeleteDatabase(ctx context.Context, id string) (*DeleteDatabaseResponse, error)
 	GetCredential(ctx context.Context, id string) (*GetCredentialResponse, error)
 	CreateCredential(ctx context.Context, input CreateCredentialInput) (*CreateCredentialResponse, error)
 	UpdateCredential(ctx context.Context, id string, input UpdateCredentialInput) (*UpdateCredentialResponse, error)
 	DeleteCredential(ctx context.Context, id string) (*DeleteCredentialResponse, error)
+	GetDatabaseAccess(ctx context.Context, id string) (*GetDatabaseAccessResponse, error)
+	CreateDatabaseAccess(ctx context.Context, input CreateDatabaseAccessInput) (*CreateDatabaseAccessResponse, error)
+	UpdateDatabaseAccess(ctx context.Context, id string, input UpdateDatabaseAccessInput) (*UpdateDatabaseAccessResponse, error)
+	DeleteDatabaseAccess(ctx context.Context, id string) (*DeleteDatabaseAccessResponse, error)
+	GetReviewPolicy(ctx context.Context, id string) (*GetReviewPolicyResponse, error)
+	CreateReviewPolicy(ctx context.Context, input CreateReviewPolicyInput) (*CreateReviewPolicyResponse, error)
+	UpdateReviewPolicy(ctx context.Context, id string, input UpdateReviewPolicyInput) (*UpdateReviewPolicyResponse, error)
+	DeleteReviewPolicy(ctx context.Context, id string) (*DeleteReviewPolicyResponse, error)
+	GetUser(ctx context.Context, id string) (*GetUserResponse, error)
+	CreateUser(ctx context.Context, input CreateUserInput) (*CreateUserResponse, error)
+	UpdateUser(ctx context.Context, id string, input UpdateUserInput) (*UpdateUserResponse, error)
+	DeleteUser(ctx context.Context, id string) (*DeleteUserResponse, error)
+	GetTeam(ctx context.Context, id string) (*GetTeamResponse, error)
+	CreateTeam(ctx context.Context, input CreateTeamInput) (*CreateTeamResponse, error)
+	UpdateTeam(ctx context.Context, id string, input UpdateTeamInput) (*UpdateTeamResponse, error)
+	DeleteTeam(ctx context.Context, id string) (*DeleteTeamResponse, error)
+	GetTeamMembership(ctx context.Context, id string) (*GetTeamMembershipResponse, error)
+	CreateTeamMembership(ctx context.Context, input CreateTeamMembershipInput) (*CreateTeamMembershipResponse, error)
+	DeleteTeamMembership(ctx context.Context, id string) (*DeleteTeamMembershipResponse, error)
+	GetApiKey(ctx context.Context, id string) (*GetApiKeyResponse, error)
+	GetCurrentApiKey(ctx context.Context) (*GetCurrentApiKeyResponse, error)
+	CreateApiKey(ctx context.Context, input CreateApiKeyInput) (*CreateApiKeyResponse, error)
+	UpdateApiKey(ctx context.Context, id string, input UpdateApiKeyInput) (*UpdateApiKeyResponse, error)
+	RotateApiKey(ctx context.Context, id string) (*RotateApiKeyResponse, error)
+	DeleteApiKey(ctx context.Context, id string) (*DeleteApiKeyResponse, error)
+	ListAuditEvents(ctx context.Context, filter AuditEventFilterInput, limit int, offset int) (*ListAuditEventsResponse, error)
+	Ping(ctx context.Context) (*PingResponse, error)
 }
 
 type GraphQLReq struct {
@@ -83,6 +385,10 @@ func (c GraphQLReq) GetDatabase(ctx context.Context, id string) (*GetDatabaseRes
 	return getDatabase(ctx, c.Client, id)
 }
 
+func (c GraphQLReq) ListDatabases(ctx context.Context, limit int, offset int) (*ListDatabasesResponse, error) {
+	return listDatabases(ctx, c.Client, limit, offset)
+}
+
 func (c GraphQLReq) CreateDatabase(ctx context.Context, input CreateDatabaseInput) (*CreateDatabaseResponse, error) {
 	return createDatabase(ctx, c.Client, input)
 }
@@ -91,6 +397,18 @@ func (c GraphQLReq) UpdateDatabase(ctx context.Context, id string, input UpdateD
 	return updateDatabase(ctx, c.Client, id, input)
 }
 
+func (c GraphQLReq) GetDatabaseCredentials(ctx context.Context, id string) (*GetDatabaseCredentialsResponse, error) {
+	return getDatabaseCredentials(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) FindDatabasesByName(ctx context.Context, name string) (*FindDatabasesByNameResponse, error) {
+	return findDatabasesByName(ctx, c.Client, name)
+}
+
+func (c GraphQLReq) FindCredentialsByUsername(ctx context.Context, databaseId string, username string) (*FindCredentialsByUsernameResponse, error) {
+	return findCredentialsByUsername(ctx, c.Client, databaseId, username)
+}
+
 func (c GraphQLReq) DeleteDatabase(ctx context.Context, id string) (*DeleteDatabaseResponse, error) {
 	return deleteDatabase(ctx, c.Client, id)
 }
@@ -110,3 +428,111 @@ func (c GraphQLReq) UpdateCredential(ctx context.Context, id string, input Updat
 func (c GraphQLReq) DeleteCredential(ctx context.Context, id string) (*DeleteCredentialResponse, error) {
 	return deleteCredential(ctx, c.Client, id)
 }
+
+func (c GraphQLReq) GetDatabaseAccess(ctx context.Context, id string) (*GetDatabaseAccessResponse, error) {
+	return getDatabaseAccess(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) CreateDatabaseAccess(ctx context.Context, input CreateDatabaseAccessInput) (*CreateDatabaseAccessResponse, error) {
+	return createDatabaseAccess(ctx, c.Client, input)
+}
+
+func (c GraphQLReq) UpdateDatabaseAccess(ctx context.Context, id string, input UpdateDatabaseAccessInput) (*UpdateDatabaseAccessResponse, error) {
+	return updateDatabaseAccess(ctx, c.Client, id, input)
+}
+
+func (c GraphQLReq) DeleteDatabaseAccess(ctx context.Context, id string) (*DeleteDatabaseAccessResponse, error) {
+	return deleteDatabaseAccess(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) GetReviewPolicy(ctx context.Context, id string) (*GetReviewPolicyResponse, error) {
+	return getReviewPolicy(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) CreateReviewPolicy(ctx context.Context, input CreateReviewPolicyInput) (*CreateReviewPolicyResponse, error) {
+	return createReviewPolicy(ctx, c.Client, input)
+}
+
+func (c GraphQLReq) UpdateReviewPolicy(ctx context.Context, id string, input UpdateReviewPolicyInput) (*UpdateReviewPolicyResponse, error) {
+	return updateReviewPolicy(ctx, c.Client, id, input)
+}
+
+func (c GraphQLReq) DeleteReviewPolicy(ctx context.Context, id string) (*DeleteReviewPolicyResponse, error) {
+	return deleteReviewPolicy(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) GetUser(ctx context.Context, id string) (*GetUserResponse, error) {
+	return getUser(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) CreateUser(ctx context.Context, input CreateUserInput) (*CreateUserResponse, error) {
+	return createUser(ctx, c.Client, input)
+}
+
+func (c GraphQLReq) UpdateUser(ctx context.Context, id string, input UpdateUserInput) (*UpdateUserResponse, error) {
+	return updateUser(ctx, c.Client, id, input)
+}
+
+func (c GraphQLReq) DeleteUser(ctx context.Context, id string) (*DeleteUserResponse, error) {
+	return deleteUser(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) GetTeam(ctx context.Context, id string) (*GetTeamResponse, error) {
+	return getTeam(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) CreateTeam(ctx context.Context, input CreateTeamInput) (*CreateTeamResponse, error) {
+	return createTeam(ctx, c.Client, input)
+}
+
+func (c GraphQLReq) UpdateTeam(ctx context.Context, id string, input UpdateTeamInput) (*UpdateTeamResponse, error) {
+	return updateTeam(ctx, c.Client, id, input)
+}
+
+func (c GraphQLReq) DeleteTeam(ctx context.Context, id string) (*DeleteTeamResponse, error) {
+	return deleteTeam(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) GetTeamMembership(ctx context.Context, id string) (*GetTeamMembershipResponse, error) {
+	return getTeamMembership(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) CreateTeamMembership(ctx context.Context, input CreateTeamMembershipInput) (*CreateTeamMembershipResponse, error) {
+	return createTeamMembership(ctx, c.Client, input)
+}
+
+func (c GraphQLReq) DeleteTeamMembership(ctx context.Context, id string) (*DeleteTeamMembershipResponse, error) {
+	return deleteTeamMembership(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) GetApiKey(ctx context.Context, id string) (*GetApiKeyResponse, error) {
+	return getApiKey(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) GetCurrentApiKey(ctx context.Context) (*GetCurrentApiKeyResponse, error) {
+	return getCurrentApiKey(ctx, c.Client)
+}
+
+func (c GraphQLReq) CreateApiKey(ctx context.Context, input CreateApiKeyInput) (*CreateApiKeyResponse, error) {
+	return createApiKey(ctx, c.Client, input)
+}
+
+func (c GraphQLReq) UpdateApiKey(ctx context.Context, id string, input UpdateApiKeyInput) (*UpdateApiKeyResponse, error) {
+	return updateApiKey(ctx, c.Client, id, input)
+}
+
+func (c GraphQLReq) RotateApiKey(ctx context.Context, id string) (*RotateApiKeyResponse, error) {
+	return rotateApiKey(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) DeleteApiKey(ctx context.Context, id string) (*DeleteApiKeyResponse, error) {
+	return deleteApiKey(ctx, c.Client, id)
+}
+
+func (c GraphQLReq) ListAuditEvents(ctx context.Context, filter AuditEventFilterInput, limit int, offset int) (*ListAuditEventsResponse, error) {
+	return listAuditEvents(ctx, c.Client, filter, limit, offset)
+}
+
+func (c GraphQLReq) Ping(ctx context.Context) (*PingResponse, error) {
+	return ping(ctx, c.Client)
+}
@@ -0,0 +1,313 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serverCertPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func generateClientCert(t *testing.T) (certPEM string, keyPEM string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	return certPEM, keyPEM
+}
+
+func get(t *testing.T, opts ClientOptions, url string) error {
+	transport, err := newTransport(opts)
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+
+	return err
+}
+
+func TestNewTransport_UntrustedServer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	assert.Error(t, get(t, ClientOptions{}, server.URL))
+}
+
+func TestNewTransport_CACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	assert.NoError(t, get(t, ClientOptions{CACertPEM: serverCertPEM(server)}, server.URL))
+}
+
+func TestNewTransport_CACertFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(file, []byte(serverCertPEM(server)), 0600))
+
+	assert.NoError(t, get(t, ClientOptions{CACertFile: file}, server.URL))
+}
+
+func TestNewTransport_InvalidCACert(t *testing.T) {
+	_, err := newTransport(ClientOptions{CACertPEM: "not a certificate"})
+	assert.ErrorContains(t, err, "no valid certificates")
+
+	_, err = newTransport(ClientOptions{CACertFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.ErrorContains(t, err, "reading ca cert file")
+}
+
+func TestNewTransport_InsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	assert.NoError(t, get(t, ClientOptions{InsecureSkipVerify: true}, server.URL))
+}
+
+func TestNewTransport_ClientCert(t *testing.T) {
+	certPEM, keyPEM := generateClientCert(t)
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM([]byte(certPEM)))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	assert.Error(t, get(t, ClientOptions{CACertPEM: serverCertPEM(server)}, server.URL))
+	assert.NoError(t, get(t, ClientOptions{
+		CACertPEM:     serverCertPEM(server),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	}, server.URL))
+
+	_, err := newTransport(ClientOptions{ClientCertPEM: certPEM})
+	assert.ErrorContains(t, err, "loading client certificate")
+}
+
+func TestNewTransport_ProxyURL(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+	}))
+	defer proxy.Close()
+
+	assert.NoError(t, get(t, ClientOptions{ProxyURL: proxy.URL}, "http://querydesk.internal/graphql"))
+	assert.True(t, proxied)
+
+	_, err := newTransport(ClientOptions{ProxyURL: "proxy.internal:3128"})
+	assert.ErrorContains(t, err, "parsing proxy url")
+}
+
+func TestNewClient_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{RequestTimeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+
+	_, err = getDatabase(context.Background(), *c, "db_12345")
+	assert.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestNewClient_Headers(t *testing.T) {
+	var requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test", r.Header.Get("x-api-key"))
+		assert.Equal(t, "terraform-provider-querydesk/test terraform/1.5.0", r.Header.Get("User-Agent"))
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{UserAgent: "terraform-provider-querydesk/test terraform/1.5.0"})
+	require.NoError(t, err)
+
+	_, err = getDatabase(context.Background(), *c, "db_12345")
+	require.Error(t, err)
+	_, err = getDatabase(context.Background(), *c, "db_12345")
+	require.Error(t, err)
+
+	require.Len(t, requestIDs, 2)
+	assert.NotEmpty(t, requestIDs[0])
+	assert.NotEqual(t, requestIDs[0], requestIDs[1])
+	assert.ErrorContains(t, err, "(request id: "+requestIDs[1]+")")
+}
+
+func TestNewClient_ResponseErrors(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		contentType string
+		body        string
+		want        error
+	}{
+		"unauthorized": {status: http.StatusUnauthorized, contentType: "application/json", body: `{}`, want: ErrUnauthorized},
+		"forbidden":    {status: http.StatusForbidden, contentType: "application/json", body: `{}`, want: ErrUnauthorized},
+		"not found":    {status: http.StatusNotFound, contentType: "text/html", body: `<html></html>`, want: ErrUnexpectedResponse},
+		"html page":    {status: http.StatusOK, contentType: "text/html; charset=utf-8", body: `<html></html>`, want: ErrUnexpectedResponse},
+		"rate limited": {status: http.StatusTooManyRequests, contentType: "application/json", body: `{}`, want: ErrRateLimited},
+		"server error": {status: http.StatusBadGateway, contentType: "text/html", body: `<html></html>`, want: ErrServer},
+		"null record":  {status: http.StatusOK, contentType: "application/json", body: `{"data":{"database":null}}`, want: ErrNotFound},
+		"graphql not found": {
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"data":{"database":null},"errors":[{"message":"could not be found","code":"not_found"}]}`,
+			want:        ErrNotFound,
+		},
+		"graphql forbidden": {
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"data":null,"errors":[{"message":"forbidden","extensions":{"code":"forbidden"}}]}`,
+			want:        ErrUnauthorized,
+		},
+		"mutation not found": {
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"data":{"deleteDatabase":{"result":null,"errors":[{"message":"could not be found","code":"not_found"}]}}}`,
+			want:        ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", test.contentType)
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			host := server.URL
+			apiKey := "test"
+			c, err := NewClient(&host, &apiKey, ClientOptions{})
+			require.NoError(t, err)
+
+			_, err = ping(context.Background(), *c)
+			assert.ErrorIs(t, err, test.want)
+		})
+	}
+}
+
+func TestNewClient_ValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"createDatabase":{"result":null,"errors":[
+			{"message":"has already been taken","code":"invalid_attribute","fields":["name"]},
+			{"message":"is invalid","code":"invalid_attribute","fields":["hostname"]}
+		]}}}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{})
+	require.NoError(t, err)
+
+	_, err = GraphQLReq{Client: *c}.CreateDatabase(context.Background(), CreateDatabaseInput{Name: "one"})
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "invalid_attribute", validationErr.Code)
+	assert.Equal(t, []string{"name", "hostname"}, validationErr.Fields)
+	assert.Equal(t, "has already been taken; is invalid", validationErr.Message)
+	assert.Contains(t, err.Error(), "request id:")
+}
+
+func TestNewClient_GraphQLError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Argument \"id\" has invalid value"}]}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{})
+	require.NoError(t, err)
+
+	_, err = GraphQLReq{Client: *c}.GetDatabase(context.Background(), "db_1")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "has invalid value")
+}
+
+func TestNewClient_NullCurrentApiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"currentApiKey":null}}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{})
+	require.NoError(t, err)
+
+	resp, err := GraphQLReq{Client: *c}.GetCurrentApiKey(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, resp.CurrentApiKey.Id)
+}
+
+func TestNewClient_Ping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"__typename":"RootQueryType"}}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{})
+	require.NoError(t, err)
+
+	resp, err := GraphQLReq{Client: *c}.Ping(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "RootQueryType", resp.Typename)
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// ErrUnauthorized is returned when the QueryDesk API rejects the api key.
+var ErrUnauthorized = errors.New("the QueryDesk API rejected the api key")
+
+// ErrUnexpectedResponse is returned when the host does not answer like a
+// QueryDesk GraphQL API, e.g. because it serves a web page instead.
+var ErrUnexpectedResponse = errors.New("unexpected response from the QueryDesk API")
+
+// ErrNotFound is returned when the requested record does not exist, or no
+// longer exists by the time it is updated or deleted.
+var ErrNotFound = errors.New("not found")
+
+// ErrRateLimited is returned when the QueryDesk API throttles requests.
+var ErrRateLimited = errors.New("rate limited by the QueryDesk API")
+
+// ErrServer is returned when the QueryDesk API fails with a 5xx status.
+var ErrServer = errors.New("the QueryDesk API returned a server error")
+
+// ValidationError is returned when the QueryDesk API rejects the input of a
+// mutation. Fields are the camelCase input fields at fault, if known.
+//
+// The client owns mutation errors: any errors in a mutation payload are
+// returned as a ValidationError, or ErrNotFound, before the response is
+// decoded, so callers never need to check the errors field of a payload.
+type ValidationError struct {
+	Message string
+	Code    string
+	Fields  []string
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s (fields: %s)", e.Message, strings.Join(e.Fields, ", "))
+}
+
+// checkedDoer inspects API responses before genqlient decodes them, turning
+// failures into the errors above so that callers can branch on them with
+// errors.Is and errors.As.
+type checkedDoer struct {
+	wrapped graphql.Doer
+}
+
+func (d *checkedDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.wrapped.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		discard(resp)
+		return nil, fmt.Errorf("%w: %s", ErrUnauthorized, resp.Status)
+	case resp.StatusCode == http.StatusNotFound:
+		discard(resp)
+		return nil, fmt.Errorf("%w: %s returned %s", ErrUnexpectedResponse, req.URL, resp.Status)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		discard(resp)
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			return nil, fmt.Errorf("%w: retry after %s", ErrRateLimited, retryAfter)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrRateLimited, resp.Status)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		discard(resp)
+		return nil, fmt.Errorf("%w: %s", ErrServer, resp.Status)
+	case resp.StatusCode == http.StatusOK && !isJSON(resp.Header.Get("Content-Type")):
+		discard(resp)
+		return nil, fmt.Errorf("%w: %s returned content type %q", ErrUnexpectedResponse, req.URL, resp.Header.Get("Content-Type"))
+	case resp.StatusCode != http.StatusOK:
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := responseError(body); err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// apiError is the shape of both top level GraphQL errors and the errors
+// returned in the payload of mutations.
+type apiError struct {
+	Message    string   `json:"message"`
+	Code       string   `json:"code"`
+	Fields     []string `json:"fields"`
+	Extensions struct {
+		Code   string   `json:"code"`
+		Fields []string `json:"fields"`
+	} `json:"extensions"`
+}
+
+func (e apiError) code() string {
+	if e.Code != "" {
+		return e.Code
+	}
+
+	return e.Extensions.Code
+}
+
+func (e apiError) fields() []string {
+	if len(e.Fields) > 0 {
+		return e.Fields
+	}
+
+	return e.Extensions.Fields
+}
+
+// lookupFields are the root query fields that look up a record by id, which
+// return null if it does not exist. Other nullable root fields, such as
+// currentApiKey, are left for the caller to check.
+var lookupFields = map[string]bool{
+	"apiKey":         true,
+	"credential":     true,
+	"database":       true,
+	"databaseAccess": true,
+	"reviewPolicy":   true,
+	"team":           true,
+	"teamMembership": true,
+	"user":           true,
+}
+
+// responseError finds errors in a successful GraphQL response: missing
+// records, permission errors and mutations that returned errors. Any other
+// GraphQL errors are left for genqlient to report.
+func responseError(body []byte) error {
+	var payload struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors []apiError                 `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+
+	for _, e := range payload.Errors {
+		switch e.code() {
+		case "not_found":
+			return fmt.Errorf("%w: %s", ErrNotFound, e.Message)
+		case "forbidden":
+			return fmt.Errorf("%w: %s", ErrUnauthorized, e.Message)
+		}
+	}
+
+	if len(payload.Errors) > 0 {
+		return nil
+	}
+
+	for field, raw := range payload.Data {
+		if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+			if lookupFields[field] {
+				return ErrNotFound
+			}
+			continue
+		}
+
+		var result struct {
+			Errors []apiError `json:"errors"`
+		}
+
+		if err := json.Unmarshal(raw, &result); err != nil || len(result.Errors) == 0 {
+			continue
+		}
+
+		validationErr := &ValidationError{Code: result.Errors[0].code()}
+		messages := make([]string, 0, len(result.Errors))
+
+		for _, e := range result.Errors {
+			if e.code() == "not_found" {
+				return fmt.Errorf("%w: %s", ErrNotFound, e.Message)
+			}
+
+			messages = append(messages, e.Message)
+			validationErr.Fields = append(validationErr.Fields, e.fields()...)
+		}
+
+		validationErr.Message = strings.Join(messages, "; ")
+
+		return validationErr
+	}
+
+	return nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || mediaType == "application/graphql-response+json")
+}
+
+func discard(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
@@ -4,16 +4,263 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
+type AuditEventApprovalStatus string
+
+const (
+	// The query did not need a review
+	AuditEventApprovalStatusNotRequired AuditEventApprovalStatus = "NOT_REQUIRED"
+	AuditEventApprovalStatusPending     AuditEventApprovalStatus = "PENDING"
+	AuditEventApprovalStatusApproved    AuditEventApprovalStatus = "APPROVED"
+	AuditEventApprovalStatusRejected    AuditEventApprovalStatus = "REJECTED"
+)
+
+type AuditEventFilterApprovalStatus struct {
+	IsNil              bool                       `json:"isNil,omitempty"`
+	Eq                 AuditEventApprovalStatus   `json:"eq,omitempty"`
+	NotEq              AuditEventApprovalStatus   `json:"notEq,omitempty"`
+	In                 []AuditEventApprovalStatus `json:"in,omitempty"`
+	LessThan           AuditEventApprovalStatus   `json:"lessThan,omitempty"`
+	GreaterThan        AuditEventApprovalStatus   `json:"greaterThan,omitempty"`
+	LessThanOrEqual    AuditEventApprovalStatus   `json:"lessThanOrEqual,omitempty"`
+	GreaterThanOrEqual AuditEventApprovalStatus   `json:"greaterThanOrEqual,omitempty"`
+}
+
+// GetIsNil returns AuditEventFilterApprovalStatus.IsNil, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetIsNil() bool { return v.IsNil }
+
+// GetEq returns AuditEventFilterApprovalStatus.Eq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetEq() AuditEventApprovalStatus { return v.Eq }
+
+// GetNotEq returns AuditEventFilterApprovalStatus.NotEq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetNotEq() AuditEventApprovalStatus { return v.NotEq }
+
+// GetIn returns AuditEventFilterApprovalStatus.In, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetIn() []AuditEventApprovalStatus { return v.In }
+
+// GetLessThan returns AuditEventFilterApprovalStatus.LessThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetLessThan() AuditEventApprovalStatus { return v.LessThan }
+
+// GetGreaterThan returns AuditEventFilterApprovalStatus.GreaterThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetGreaterThan() AuditEventApprovalStatus {
+	return v.GreaterThan
+}
+
+// GetLessThanOrEqual returns AuditEventFilterApprovalStatus.LessThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetLessThanOrEqual() AuditEventApprovalStatus {
+	return v.LessThanOrEqual
+}
+
+// GetGreaterThanOrEqual returns AuditEventFilterApprovalStatus.GreaterThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterApprovalStatus) GetGreaterThanOrEqual() AuditEventApprovalStatus {
+	return v.GreaterThanOrEqual
+}
+
+type AuditEventFilterCredentialId struct {
+	IsNil              bool     `json:"isNil,omitempty"`
+	Eq                 string   `json:"eq,omitempty"`
+	NotEq              string   `json:"notEq,omitempty"`
+	In                 []string `json:"in,omitempty"`
+	LessThan           string   `json:"lessThan,omitempty"`
+	GreaterThan        string   `json:"greaterThan,omitempty"`
+	LessThanOrEqual    string   `json:"lessThanOrEqual,omitempty"`
+	GreaterThanOrEqual string   `json:"greaterThanOrEqual,omitempty"`
+}
+
+// GetIsNil returns AuditEventFilterCredentialId.IsNil, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetIsNil() bool { return v.IsNil }
+
+// GetEq returns AuditEventFilterCredentialId.Eq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetEq() string { return v.Eq }
+
+// GetNotEq returns AuditEventFilterCredentialId.NotEq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetNotEq() string { return v.NotEq }
+
+// GetIn returns AuditEventFilterCredentialId.In, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetIn() []string { return v.In }
+
+// GetLessThan returns AuditEventFilterCredentialId.LessThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetLessThan() string { return v.LessThan }
+
+// GetGreaterThan returns AuditEventFilterCredentialId.GreaterThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetGreaterThan() string { return v.GreaterThan }
+
+// GetLessThanOrEqual returns AuditEventFilterCredentialId.LessThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetLessThanOrEqual() string { return v.LessThanOrEqual }
+
+// GetGreaterThanOrEqual returns AuditEventFilterCredentialId.GreaterThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterCredentialId) GetGreaterThanOrEqual() string { return v.GreaterThanOrEqual }
+
+type AuditEventFilterDatabaseId struct {
+	IsNil              bool     `json:"isNil,omitempty"`
+	Eq                 string   `json:"eq,omitempty"`
+	NotEq              string   `json:"notEq,omitempty"`
+	In                 []string `json:"in,omitempty"`
+	LessThan           string   `json:"lessThan,omitempty"`
+	GreaterThan        string   `json:"greaterThan,omitempty"`
+	LessThanOrEqual    string   `json:"lessThanOrEqual,omitempty"`
+	GreaterThanOrEqual string   `json:"greaterThanOrEqual,omitempty"`
+}
+
+// GetIsNil returns AuditEventFilterDatabaseId.IsNil, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetIsNil() bool { return v.IsNil }
+
+// GetEq returns AuditEventFilterDatabaseId.Eq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetEq() string { return v.Eq }
+
+// GetNotEq returns AuditEventFilterDatabaseId.NotEq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetNotEq() string { return v.NotEq }
+
+// GetIn returns AuditEventFilterDatabaseId.In, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetIn() []string { return v.In }
+
+// GetLessThan returns AuditEventFilterDatabaseId.LessThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetLessThan() string { return v.LessThan }
+
+// GetGreaterThan returns AuditEventFilterDatabaseId.GreaterThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetGreaterThan() string { return v.GreaterThan }
+
+// GetLessThanOrEqual returns AuditEventFilterDatabaseId.LessThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetLessThanOrEqual() string { return v.LessThanOrEqual }
+
+// GetGreaterThanOrEqual returns AuditEventFilterDatabaseId.GreaterThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterDatabaseId) GetGreaterThanOrEqual() string { return v.GreaterThanOrEqual }
+
+type AuditEventFilterInput struct {
+	And            []AuditEventFilterInput         `json:"and,omitempty"`
+	Or             []AuditEventFilterInput         `json:"or,omitempty"`
+	DatabaseId     *AuditEventFilterDatabaseId     `json:"databaseId,omitempty"`
+	CredentialId   *AuditEventFilterCredentialId   `json:"credentialId,omitempty"`
+	UserId         *AuditEventFilterUserId         `json:"userId,omitempty"`
+	InsertedAt     *AuditEventFilterInsertedAt     `json:"insertedAt,omitempty"`
+	ApprovalStatus *AuditEventFilterApprovalStatus `json:"approvalStatus,omitempty"`
+}
+
+// GetAnd returns AuditEventFilterInput.And, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetAnd() []AuditEventFilterInput { return v.And }
+
+// GetOr returns AuditEventFilterInput.Or, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetOr() []AuditEventFilterInput { return v.Or }
+
+// GetDatabaseId returns AuditEventFilterInput.DatabaseId, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetDatabaseId() *AuditEventFilterDatabaseId { return v.DatabaseId }
+
+// GetCredentialId returns AuditEventFilterInput.CredentialId, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetCredentialId() *AuditEventFilterCredentialId {
+	return v.CredentialId
+}
+
+// GetUserId returns AuditEventFilterInput.UserId, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetUserId() *AuditEventFilterUserId { return v.UserId }
+
+// GetInsertedAt returns AuditEventFilterInput.InsertedAt, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetInsertedAt() *AuditEventFilterInsertedAt { return v.InsertedAt }
+
+// GetApprovalStatus returns AuditEventFilterInput.ApprovalStatus, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInput) GetApprovalStatus() *AuditEventFilterApprovalStatus {
+	return v.ApprovalStatus
+}
+
+type AuditEventFilterInsertedAt struct {
+	IsNil              bool     `json:"isNil,omitempty"`
+	Eq                 string   `json:"eq,omitempty"`
+	NotEq              string   `json:"notEq,omitempty"`
+	In                 []string `json:"in,omitempty"`
+	LessThan           string   `json:"lessThan,omitempty"`
+	GreaterThan        string   `json:"greaterThan,omitempty"`
+	LessThanOrEqual    string   `json:"lessThanOrEqual,omitempty"`
+	GreaterThanOrEqual string   `json:"greaterThanOrEqual,omitempty"`
+}
+
+// GetIsNil returns AuditEventFilterInsertedAt.IsNil, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetIsNil() bool { return v.IsNil }
+
+// GetEq returns AuditEventFilterInsertedAt.Eq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetEq() string { return v.Eq }
+
+// GetNotEq returns AuditEventFilterInsertedAt.NotEq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetNotEq() string { return v.NotEq }
+
+// GetIn returns AuditEventFilterInsertedAt.In, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetIn() []string { return v.In }
+
+// GetLessThan returns AuditEventFilterInsertedAt.LessThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetLessThan() string { return v.LessThan }
+
+// GetGreaterThan returns AuditEventFilterInsertedAt.GreaterThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetGreaterThan() string { return v.GreaterThan }
+
+// GetLessThanOrEqual returns AuditEventFilterInsertedAt.LessThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetLessThanOrEqual() string { return v.LessThanOrEqual }
+
+// GetGreaterThanOrEqual returns AuditEventFilterInsertedAt.GreaterThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterInsertedAt) GetGreaterThanOrEqual() string { return v.GreaterThanOrEqual }
+
+type AuditEventFilterUserId struct {
+	IsNil              bool     `json:"isNil,omitempty"`
+	Eq                 string   `json:"eq,omitempty"`
+	NotEq              string   `json:"notEq,omitempty"`
+	In                 []string `json:"in,omitempty"`
+	LessThan           string   `json:"lessThan,omitempty"`
+	GreaterThan        string   `json:"greaterThan,omitempty"`
+	LessThanOrEqual    string   `json:"lessThanOrEqual,omitempty"`
+	GreaterThanOrEqual string   `json:"greaterThanOrEqual,omitempty"`
+}
+
+// GetIsNil returns AuditEventFilterUserId.IsNil, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetIsNil() bool { return v.IsNil }
+
+// GetEq returns AuditEventFilterUserId.Eq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetEq() string { return v.Eq }
+
+// GetNotEq returns AuditEventFilterUserId.NotEq, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetNotEq() string { return v.NotEq }
+
+// GetIn returns AuditEventFilterUserId.In, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetIn() []string { return v.In }
+
+// GetLessThan returns AuditEventFilterUserId.LessThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetLessThan() string { return v.LessThan }
+
+// GetGreaterThan returns AuditEventFilterUserId.GreaterThan, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetGreaterThan() string { return v.GreaterThan }
+
+// GetLessThanOrEqual returns AuditEventFilterUserId.LessThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetLessThanOrEqual() string { return v.LessThanOrEqual }
+
+// GetGreaterThanOrEqual returns AuditEventFilterUserId.GreaterThanOrEqual, and is useful for accessing the field via an interface.
+func (v *AuditEventFilterUserId) GetGreaterThanOrEqual() string { return v.GreaterThanOrEqual }
+
+type CreateApiKeyInput struct {
+	Name        string   `json:"name"`
+	ReadOnly    bool     `json:"readOnly,omitempty"`
+	DatabaseIds []string `json:"databaseIds,omitempty"`
+	ExpiresAt   string   `json:"expiresAt,omitempty"`
+}
+
+// GetName returns CreateApiKeyInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateApiKeyInput) GetName() string { return v.Name }
+
+// GetReadOnly returns CreateApiKeyInput.ReadOnly, and is useful for accessing the field via an interface.
+func (v *CreateApiKeyInput) GetReadOnly() bool { return v.ReadOnly }
+
+// GetDatabaseIds returns CreateApiKeyInput.DatabaseIds, and is useful for accessing the field via an interface.
+func (v *CreateApiKeyInput) GetDatabaseIds() []string { return v.DatabaseIds }
+
+// GetExpiresAt returns CreateApiKeyInput.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *CreateApiKeyInput) GetExpiresAt() string { return v.ExpiresAt }
+
 type CreateCredentialInput struct {
 	Description     string `json:"description"`
 	Username        string `json:"username"`
 	ReviewsRequired int    `json:"reviewsRequired"`
 	Password        string `json:"password"`
 	DatabaseId      string `json:"databaseId"`
+	ReviewPolicyId  string `json:"reviewPolicyId,omitempty"`
 }
 
 // GetDescription returns CreateCredentialInput.Description, and is useful for accessing the field via an interface.
@@ -31,17 +278,42 @@ func (v *CreateCredentialInput) GetPassword() string { return v.Password }
 // GetDatabaseId returns CreateCredentialInput.DatabaseId, and is useful for accessing the field via an interface.
 func (v *CreateCredentialInput) GetDatabaseId() string { return v.DatabaseId }
 
+// GetReviewPolicyId returns CreateCredentialInput.ReviewPolicyId, and is useful for accessing the field via an interface.
+func (v *CreateCredentialInput) GetReviewPolicyId() string { return v.ReviewPolicyId }
+
+type CreateDatabaseAccessInput struct {
+	DatabaseId    string   `json:"databaseId"`
+	UserId        string   `json:"userId,omitempty"`
+	TeamId        string   `json:"teamId,omitempty"`
+	CredentialIds []string `json:"credentialIds,omitempty"`
+}
+
+// GetDatabaseId returns CreateDatabaseAccessInput.DatabaseId, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseAccessInput) GetDatabaseId() string { return v.DatabaseId }
+
+// GetUserId returns CreateDatabaseAccessInput.UserId, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseAccessInput) GetUserId() string { return v.UserId }
+
+// GetTeamId returns CreateDatabaseAccessInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseAccessInput) GetTeamId() string { return v.TeamId }
+
+// GetCredentialIds returns CreateDatabaseAccessInput.CredentialIds, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseAccessInput) GetCredentialIds() []string { return v.CredentialIds }
+
 type CreateDatabaseInput struct {
-	Name           string          `json:"name"`
-	Adapter        DatabaseAdapter `json:"adapter"`
-	Hostname       string          `json:"hostname"`
-	Database       string          `json:"database"`
-	Ssl            bool            `json:"ssl"`
-	RestrictAccess bool            `json:"restrictAccess"`
-	Cacertfile     string          `json:"cacertfile"`
-	Keyfile        string          `json:"keyfile"`
-	Certfile       string          `json:"certfile"`
-	AgentId        string          `json:"agentId"`
+	Name             string                         `json:"name"`
+	Adapter          DatabaseAdapter                `json:"adapter"`
+	Hostname         string                         `json:"hostname"`
+	Database         string                         `json:"database"`
+	Ssl              bool                           `json:"ssl"`
+	RestrictAccess   bool                           `json:"restrictAccess"`
+	Cacertfile       string                         `json:"cacertfile"`
+	Keyfile          string                         `json:"keyfile"`
+	Certfile         string                         `json:"certfile"`
+	AgentId          string                         `json:"agentId"`
+	PostgresOptions  *DatabasePostgresOptionsInput  `json:"postgresOptions,omitempty"`
+	MysqlOptions     *DatabaseMysqlOptionsInput     `json:"mysqlOptions,omitempty"`
+	SqlserverOptions *DatabaseSqlserverOptionsInput `json:"sqlserverOptions,omitempty"`
 }
 
 // GetName returns CreateDatabaseInput.Name, and is useful for accessing the field via an interface.
@@ -74,74 +346,272 @@ func (v *CreateDatabaseInput) GetCertfile() string { return v.Certfile }
 // GetAgentId returns CreateDatabaseInput.AgentId, and is useful for accessing the field via an interface.
 func (v *CreateDatabaseInput) GetAgentId() string { return v.AgentId }
 
+// GetPostgresOptions returns CreateDatabaseInput.PostgresOptions, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseInput) GetPostgresOptions() *DatabasePostgresOptionsInput {
+	return v.PostgresOptions
+}
+
+// GetMysqlOptions returns CreateDatabaseInput.MysqlOptions, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseInput) GetMysqlOptions() *DatabaseMysqlOptionsInput { return v.MysqlOptions }
+
+// GetSqlserverOptions returns CreateDatabaseInput.SqlserverOptions, and is useful for accessing the field via an interface.
+func (v *CreateDatabaseInput) GetSqlserverOptions() *DatabaseSqlserverOptionsInput {
+	return v.SqlserverOptions
+}
+
+type CreateReviewPolicyInput struct {
+	Name              string `json:"name"`
+	ReviewerTeamId    string `json:"reviewerTeamId,omitempty"`
+	AllowSelfApproval bool   `json:"allowSelfApproval"`
+	ReadOnlyBypass    bool   `json:"readOnlyBypass"`
+}
+
+// GetName returns CreateReviewPolicyInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateReviewPolicyInput) GetName() string { return v.Name }
+
+// GetReviewerTeamId returns CreateReviewPolicyInput.ReviewerTeamId, and is useful for accessing the field via an interface.
+func (v *CreateReviewPolicyInput) GetReviewerTeamId() string { return v.ReviewerTeamId }
+
+// GetAllowSelfApproval returns CreateReviewPolicyInput.AllowSelfApproval, and is useful for accessing the field via an interface.
+func (v *CreateReviewPolicyInput) GetAllowSelfApproval() bool { return v.AllowSelfApproval }
+
+// GetReadOnlyBypass returns CreateReviewPolicyInput.ReadOnlyBypass, and is useful for accessing the field via an interface.
+func (v *CreateReviewPolicyInput) GetReadOnlyBypass() bool { return v.ReadOnlyBypass }
+
+type CreateTeamInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetName returns CreateTeamInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateTeamInput) GetName() string { return v.Name }
+
+// GetDescription returns CreateTeamInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateTeamInput) GetDescription() string { return v.Description }
+
+type CreateTeamMembershipInput struct {
+	TeamId string `json:"teamId"`
+	UserId string `json:"userId"`
+}
+
+// GetTeamId returns CreateTeamMembershipInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CreateTeamMembershipInput) GetTeamId() string { return v.TeamId }
+
+// GetUserId returns CreateTeamMembershipInput.UserId, and is useful for accessing the field via an interface.
+func (v *CreateTeamMembershipInput) GetUserId() string { return v.UserId }
+
+type CreateUserInput struct {
+	Email string   `json:"email"`
+	Name  string   `json:"name"`
+	Role  UserRole `json:"role"`
+}
+
+// GetEmail returns CreateUserInput.Email, and is useful for accessing the field via an interface.
+func (v *CreateUserInput) GetEmail() string { return v.Email }
+
+// GetName returns CreateUserInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateUserInput) GetName() string { return v.Name }
+
+// GetRole returns CreateUserInput.Role, and is useful for accessing the field via an interface.
+func (v *CreateUserInput) GetRole() UserRole { return v.Role }
+
 type DatabaseAdapter string
 
 const (
-	DatabaseAdapterPostgres DatabaseAdapter = "POSTGRES"
-	DatabaseAdapterMysql    DatabaseAdapter = "MYSQL"
+	DatabaseAdapterPostgres  DatabaseAdapter = "POSTGRES"
+	DatabaseAdapterMysql     DatabaseAdapter = "MYSQL"
+	DatabaseAdapterSqlserver DatabaseAdapter = "SQLSERVER"
+)
+
+type DatabaseMysqlOptionsInput struct {
+	Charset   string `json:"charset,omitempty"`
+	Collation string `json:"collation,omitempty"`
+}
+
+// GetCharset returns DatabaseMysqlOptionsInput.Charset, and is useful for accessing the field via an interface.
+func (v *DatabaseMysqlOptionsInput) GetCharset() string { return v.Charset }
+
+// GetCollation returns DatabaseMysqlOptionsInput.Collation, and is useful for accessing the field via an interface.
+func (v *DatabaseMysqlOptionsInput) GetCollation() string { return v.Collation }
+
+type DatabasePostgresOptionsInput struct {
+	Sslmode    DatabasePostgresSslmode `json:"sslmode,omitempty"`
+	SearchPath string                  `json:"searchPath,omitempty"`
+}
+
+// GetSslmode returns DatabasePostgresOptionsInput.Sslmode, and is useful for accessing the field via an interface.
+func (v *DatabasePostgresOptionsInput) GetSslmode() DatabasePostgresSslmode { return v.Sslmode }
+
+// GetSearchPath returns DatabasePostgresOptionsInput.SearchPath, and is useful for accessing the field via an interface.
+func (v *DatabasePostgresOptionsInput) GetSearchPath() string { return v.SearchPath }
+
+type DatabasePostgresSslmode string
+
+const (
+	DatabasePostgresSslmodeDisable    DatabasePostgresSslmode = "DISABLE"
+	DatabasePostgresSslmodeAllow      DatabasePostgresSslmode = "ALLOW"
+	DatabasePostgresSslmodePrefer     DatabasePostgresSslmode = "PREFER"
+	DatabasePostgresSslmodeRequire    DatabasePostgresSslmode = "REQUIRE"
+	DatabasePostgresSslmodeVerifyCa   DatabasePostgresSslmode = "VERIFY_CA"
+	DatabasePostgresSslmodeVerifyFull DatabasePostgresSslmode = "VERIFY_FULL"
 )
 
+type DatabaseSqlserverOptionsInput struct {
+	TrustServerCertificate *bool `json:"trustServerCertificate,omitempty"`
+}
+
+// GetTrustServerCertificate returns DatabaseSqlserverOptionsInput.TrustServerCertificate, and is useful for accessing the field via an interface.
+func (v *DatabaseSqlserverOptionsInput) GetTrustServerCertificate() *bool {
+	return v.TrustServerCertificate
+}
+
+type UpdateApiKeyInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns UpdateApiKeyInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateApiKeyInput) GetName() string { return v.Name }
+
 type UpdateCredentialInput struct {
-	Description     string `json:"description"`
-	Username        string `json:"username"`
-	ReviewsRequired int    `json:"reviewsRequired"`
-	NewPassword     string `json:"newPassword"`
+	Description     *string         `json:"description,omitempty"`
+	Username        *string         `json:"username,omitempty"`
+	ReviewsRequired *int            `json:"reviewsRequired,omitempty"`
+	NewPassword     *string         `json:"newPassword,omitempty"`
+	ReviewPolicyId  json.RawMessage `json:"reviewPolicyId,omitempty"`
 }
 
 // GetDescription returns UpdateCredentialInput.Description, and is useful for accessing the field via an interface.
-func (v *UpdateCredentialInput) GetDescription() string { return v.Description }
+func (v *UpdateCredentialInput) GetDescription() *string { return v.Description }
 
 // GetUsername returns UpdateCredentialInput.Username, and is useful for accessing the field via an interface.
-func (v *UpdateCredentialInput) GetUsername() string { return v.Username }
+func (v *UpdateCredentialInput) GetUsername() *string { return v.Username }
 
 // GetReviewsRequired returns UpdateCredentialInput.ReviewsRequired, and is useful for accessing the field via an interface.
-func (v *UpdateCredentialInput) GetReviewsRequired() int { return v.ReviewsRequired }
+func (v *UpdateCredentialInput) GetReviewsRequired() *int { return v.ReviewsRequired }
 
 // GetNewPassword returns UpdateCredentialInput.NewPassword, and is useful for accessing the field via an interface.
-func (v *UpdateCredentialInput) GetNewPassword() string { return v.NewPassword }
+func (v *UpdateCredentialInput) GetNewPassword() *string { return v.NewPassword }
+
+// GetReviewPolicyId returns UpdateCredentialInput.ReviewPolicyId, and is useful for accessing the field via an interface.
+func (v *UpdateCredentialInput) GetReviewPolicyId() json.RawMessage { return v.ReviewPolicyId }
+
+type UpdateDatabaseAccessInput struct {
+	CredentialIds []string `json:"credentialIds"`
+}
+
+// GetCredentialIds returns UpdateDatabaseAccessInput.CredentialIds, and is useful for accessing the field via an interface.
+func (v *UpdateDatabaseAccessInput) GetCredentialIds() []string { return v.CredentialIds }
 
 type UpdateDatabaseInput struct {
-	Name           string          `json:"name"`
-	Adapter        DatabaseAdapter `json:"adapter"`
-	Hostname       string          `json:"hostname"`
-	Database       string          `json:"database"`
-	Ssl            bool            `json:"ssl"`
-	RestrictAccess bool            `json:"restrictAccess"`
-	NewCacertfile  string          `json:"newCacertfile"`
-	NewKeyfile     string          `json:"newKeyfile"`
-	NewCertfile    string          `json:"newCertfile"`
-	AgentId        string          `json:"agentId"`
+	Name             *string          `json:"name,omitempty"`
+	Adapter          *DatabaseAdapter `json:"adapter,omitempty"`
+	Hostname         *string          `json:"hostname,omitempty"`
+	Database         *string          `json:"database,omitempty"`
+	Ssl              *bool            `json:"ssl,omitempty"`
+	RestrictAccess   *bool            `json:"restrictAccess,omitempty"`
+	NewCacertfile    *string          `json:"newCacertfile,omitempty"`
+	NewKeyfile       *string          `json:"newKeyfile,omitempty"`
+	NewCertfile      *string          `json:"newCertfile,omitempty"`
+	AgentId          *string          `json:"agentId,omitempty"`
+	PostgresOptions  json.RawMessage  `json:"postgresOptions,omitempty"`
+	MysqlOptions     json.RawMessage  `json:"mysqlOptions,omitempty"`
+	SqlserverOptions json.RawMessage  `json:"sqlserverOptions,omitempty"`
 }
 
 // GetName returns UpdateDatabaseInput.Name, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetName() string { return v.Name }
+func (v *UpdateDatabaseInput) GetName() *string { return v.Name }
 
 // GetAdapter returns UpdateDatabaseInput.Adapter, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetAdapter() DatabaseAdapter { return v.Adapter }
+func (v *UpdateDatabaseInput) GetAdapter() *DatabaseAdapter { return v.Adapter }
 
 // GetHostname returns UpdateDatabaseInput.Hostname, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetHostname() string { return v.Hostname }
+func (v *UpdateDatabaseInput) GetHostname() *string { return v.Hostname }
 
 // GetDatabase returns UpdateDatabaseInput.Database, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetDatabase() string { return v.Database }
+func (v *UpdateDatabaseInput) GetDatabase() *string { return v.Database }
 
 // GetSsl returns UpdateDatabaseInput.Ssl, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetSsl() bool { return v.Ssl }
+func (v *UpdateDatabaseInput) GetSsl() *bool { return v.Ssl }
 
 // GetRestrictAccess returns UpdateDatabaseInput.RestrictAccess, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetRestrictAccess() bool { return v.RestrictAccess }
+func (v *UpdateDatabaseInput) GetRestrictAccess() *bool { return v.RestrictAccess }
 
 // GetNewCacertfile returns UpdateDatabaseInput.NewCacertfile, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetNewCacertfile() string { return v.NewCacertfile }
+func (v *UpdateDatabaseInput) GetNewCacertfile() *string { return v.NewCacertfile }
 
 // GetNewKeyfile returns UpdateDatabaseInput.NewKeyfile, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetNewKeyfile() string { return v.NewKeyfile }
+func (v *UpdateDatabaseInput) GetNewKeyfile() *string { return v.NewKeyfile }
 
 // GetNewCertfile returns UpdateDatabaseInput.NewCertfile, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetNewCertfile() string { return v.NewCertfile }
+func (v *UpdateDatabaseInput) GetNewCertfile() *string { return v.NewCertfile }
 
 // GetAgentId returns UpdateDatabaseInput.AgentId, and is useful for accessing the field via an interface.
-func (v *UpdateDatabaseInput) GetAgentId() string { return v.AgentId }
+func (v *UpdateDatabaseInput) GetAgentId() *string { return v.AgentId }
+
+// GetPostgresOptions returns UpdateDatabaseInput.PostgresOptions, and is useful for accessing the field via an interface.
+func (v *UpdateDatabaseInput) GetPostgresOptions() json.RawMessage { return v.PostgresOptions }
+
+// GetMysqlOptions returns UpdateDatabaseInput.MysqlOptions, and is useful for accessing the field via an interface.
+func (v *UpdateDatabaseInput) GetMysqlOptions() json.RawMessage { return v.MysqlOptions }
+
+// GetSqlserverOptions returns UpdateDatabaseInput.SqlserverOptions, and is useful for accessing the field via an interface.
+func (v *UpdateDatabaseInput) GetSqlserverOptions() json.RawMessage { return v.SqlserverOptions }
+
+type UpdateReviewPolicyInput struct {
+	Name              string  `json:"name"`
+	ReviewerTeamId    *string `json:"reviewerTeamId"`
+	AllowSelfApproval bool    `json:"allowSelfApproval"`
+	ReadOnlyBypass    bool    `json:"readOnlyBypass"`
+}
+
+// GetName returns UpdateReviewPolicyInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateReviewPolicyInput) GetName() string { return v.Name }
+
+// GetReviewerTeamId returns UpdateReviewPolicyInput.ReviewerTeamId, and is useful for accessing the field via an interface.
+func (v *UpdateReviewPolicyInput) GetReviewerTeamId() *string { return v.ReviewerTeamId }
+
+// GetAllowSelfApproval returns UpdateReviewPolicyInput.AllowSelfApproval, and is useful for accessing the field via an interface.
+func (v *UpdateReviewPolicyInput) GetAllowSelfApproval() bool { return v.AllowSelfApproval }
+
+// GetReadOnlyBypass returns UpdateReviewPolicyInput.ReadOnlyBypass, and is useful for accessing the field via an interface.
+func (v *UpdateReviewPolicyInput) GetReadOnlyBypass() bool { return v.ReadOnlyBypass }
+
+type UpdateTeamInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetName returns UpdateTeamInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateTeamInput) GetName() string { return v.Name }
+
+// GetDescription returns UpdateTeamInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateTeamInput) GetDescription() string { return v.Description }
+
+type UpdateUserInput struct {
+	Name string   `json:"name"`
+	Role UserRole `json:"role"`
+}
+
+// GetName returns UpdateUserInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateUserInput) GetName() string { return v.Name }
+
+// GetRole returns UpdateUserInput.Role, and is useful for accessing the field via an interface.
+func (v *UpdateUserInput) GetRole() UserRole { return v.Role }
+
+type UserRole string
+
+const (
+	UserRoleAdmin UserRole = "ADMIN"
+	UserRoleUser  UserRole = "USER"
+)
+
+// __createApiKeyInput is used internally by genqlient
+type __createApiKeyInput struct {
+	Input CreateApiKeyInput `json:"input"`
+}
+
+// GetInput returns __createApiKeyInput.Input, and is useful for accessing the field via an interface.
+func (v *__createApiKeyInput) GetInput() CreateApiKeyInput { return v.Input }
 
 // __createCredentialInput is used internally by genqlient
 type __createCredentialInput struct {
@@ -151,6 +621,14 @@ type __createCredentialInput struct {
 // GetInput returns __createCredentialInput.Input, and is useful for accessing the field via an interface.
 func (v *__createCredentialInput) GetInput() CreateCredentialInput { return v.Input }
 
+// __createDatabaseAccessInput is used internally by genqlient
+type __createDatabaseAccessInput struct {
+	Input CreateDatabaseAccessInput `json:"input"`
+}
+
+// GetInput returns __createDatabaseAccessInput.Input, and is useful for accessing the field via an interface.
+func (v *__createDatabaseAccessInput) GetInput() CreateDatabaseAccessInput { return v.Input }
+
 // __createDatabaseInput is used internally by genqlient
 type __createDatabaseInput struct {
 	Input CreateDatabaseInput `json:"input"`
@@ -159,6 +637,46 @@ type __createDatabaseInput struct {
 // GetInput returns __createDatabaseInput.Input, and is useful for accessing the field via an interface.
 func (v *__createDatabaseInput) GetInput() CreateDatabaseInput { return v.Input }
 
+// __createReviewPolicyInput is used internally by genqlient
+type __createReviewPolicyInput struct {
+	Input CreateReviewPolicyInput `json:"input"`
+}
+
+// GetInput returns __createReviewPolicyInput.Input, and is useful for accessing the field via an interface.
+func (v *__createReviewPolicyInput) GetInput() CreateReviewPolicyInput { return v.Input }
+
+// __createTeamInput is used internally by genqlient
+type __createTeamInput struct {
+	Input CreateTeamInput `json:"input"`
+}
+
+// GetInput returns __createTeamInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTeamInput) GetInput() CreateTeamInput { return v.Input }
+
+// __createTeamMembershipInput is used internally by genqlient
+type __createTeamMembershipInput struct {
+	Input CreateTeamMembershipInput `json:"input"`
+}
+
+// GetInput returns __createTeamMembershipInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTeamMembershipInput) GetInput() CreateTeamMembershipInput { return v.Input }
+
+// __createUserInput is used internally by genqlient
+type __createUserInput struct {
+	Input CreateUserInput `json:"input"`
+}
+
+// GetInput returns __createUserInput.Input, and is useful for accessing the field via an interface.
+func (v *__createUserInput) GetInput() CreateUserInput { return v.Input }
+
+// __deleteApiKeyInput is used internally by genqlient
+type __deleteApiKeyInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteApiKeyInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteApiKeyInput) GetId() string { return v.Id }
+
 // __deleteCredentialInput is used internally by genqlient
 type __deleteCredentialInput struct {
 	Id string `json:"id"`
@@ -167,6 +685,14 @@ type __deleteCredentialInput struct {
 // GetId returns __deleteCredentialInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteCredentialInput) GetId() string { return v.Id }
 
+// __deleteDatabaseAccessInput is used internally by genqlient
+type __deleteDatabaseAccessInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteDatabaseAccessInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteDatabaseAccessInput) GetId() string { return v.Id }
+
 // __deleteDatabaseInput is used internally by genqlient
 type __deleteDatabaseInput struct {
 	Id string `json:"id"`
@@ -175,6 +701,66 @@ type __deleteDatabaseInput struct {
 // GetId returns __deleteDatabaseInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteDatabaseInput) GetId() string { return v.Id }
 
+// __deleteReviewPolicyInput is used internally by genqlient
+type __deleteReviewPolicyInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteReviewPolicyInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteReviewPolicyInput) GetId() string { return v.Id }
+
+// __deleteTeamInput is used internally by genqlient
+type __deleteTeamInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTeamInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTeamInput) GetId() string { return v.Id }
+
+// __deleteTeamMembershipInput is used internally by genqlient
+type __deleteTeamMembershipInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTeamMembershipInput) GetId() string { return v.Id }
+
+// __deleteUserInput is used internally by genqlient
+type __deleteUserInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteUserInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteUserInput) GetId() string { return v.Id }
+
+// __findCredentialsByUsernameInput is used internally by genqlient
+type __findCredentialsByUsernameInput struct {
+	DatabaseId string `json:"databaseId"`
+	Username   string `json:"username"`
+}
+
+// GetDatabaseId returns __findCredentialsByUsernameInput.DatabaseId, and is useful for accessing the field via an interface.
+func (v *__findCredentialsByUsernameInput) GetDatabaseId() string { return v.DatabaseId }
+
+// GetUsername returns __findCredentialsByUsernameInput.Username, and is useful for accessing the field via an interface.
+func (v *__findCredentialsByUsernameInput) GetUsername() string { return v.Username }
+
+// __findDatabasesByNameInput is used internally by genqlient
+type __findDatabasesByNameInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __findDatabasesByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__findDatabasesByNameInput) GetName() string { return v.Name }
+
+// __getApiKeyInput is used internally by genqlient
+type __getApiKeyInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getApiKeyInput.Id, and is useful for accessing the field via an interface.
+func (v *__getApiKeyInput) GetId() string { return v.Id }
+
 // __getCredentialInput is used internally by genqlient
 type __getCredentialInput struct {
 	Id string `json:"id"`
@@ -183,6 +769,22 @@ type __getCredentialInput struct {
 // GetId returns __getCredentialInput.Id, and is useful for accessing the field via an interface.
 func (v *__getCredentialInput) GetId() string { return v.Id }
 
+// __getDatabaseAccessInput is used internally by genqlient
+type __getDatabaseAccessInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDatabaseAccessInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDatabaseAccessInput) GetId() string { return v.Id }
+
+// __getDatabaseCredentialsInput is used internally by genqlient
+type __getDatabaseCredentialsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDatabaseCredentialsInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDatabaseCredentialsInput) GetId() string { return v.Id }
+
 // __getDatabaseInput is used internally by genqlient
 type __getDatabaseInput struct {
 	Id string `json:"id"`
@@ -191,6 +793,86 @@ type __getDatabaseInput struct {
 // GetId returns __getDatabaseInput.Id, and is useful for accessing the field via an interface.
 func (v *__getDatabaseInput) GetId() string { return v.Id }
 
+// __getReviewPolicyInput is used internally by genqlient
+type __getReviewPolicyInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getReviewPolicyInput.Id, and is useful for accessing the field via an interface.
+func (v *__getReviewPolicyInput) GetId() string { return v.Id }
+
+// __getTeamInput is used internally by genqlient
+type __getTeamInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTeamInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTeamInput) GetId() string { return v.Id }
+
+// __getTeamMembershipInput is used internally by genqlient
+type __getTeamMembershipInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipInput) GetId() string { return v.Id }
+
+// __getUserInput is used internally by genqlient
+type __getUserInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getUserInput.Id, and is useful for accessing the field via an interface.
+func (v *__getUserInput) GetId() string { return v.Id }
+
+// __listAuditEventsInput is used internally by genqlient
+type __listAuditEventsInput struct {
+	Filter AuditEventFilterInput `json:"filter,omitempty"`
+	Limit  int                   `json:"limit,omitempty"`
+	Offset int                   `json:"offset,omitempty"`
+}
+
+// GetFilter returns __listAuditEventsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__listAuditEventsInput) GetFilter() AuditEventFilterInput { return v.Filter }
+
+// GetLimit returns __listAuditEventsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__listAuditEventsInput) GetLimit() int { return v.Limit }
+
+// GetOffset returns __listAuditEventsInput.Offset, and is useful for accessing the field via an interface.
+func (v *__listAuditEventsInput) GetOffset() int { return v.Offset }
+
+// __listDatabasesInput is used internally by genqlient
+type __listDatabasesInput struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// GetLimit returns __listDatabasesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__listDatabasesInput) GetLimit() int { return v.Limit }
+
+// GetOffset returns __listDatabasesInput.Offset, and is useful for accessing the field via an interface.
+func (v *__listDatabasesInput) GetOffset() int { return v.Offset }
+
+// __rotateApiKeyInput is used internally by genqlient
+type __rotateApiKeyInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __rotateApiKeyInput.Id, and is useful for accessing the field via an interface.
+func (v *__rotateApiKeyInput) GetId() string { return v.Id }
+
+// __updateApiKeyInput is used internally by genqlient
+type __updateApiKeyInput struct {
+	Id    string            `json:"id"`
+	Input UpdateApiKeyInput `json:"input"`
+}
+
+// GetId returns __updateApiKeyInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateApiKeyInput) GetId() string { return v.Id }
+
+// GetInput returns __updateApiKeyInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateApiKeyInput) GetInput() UpdateApiKeyInput { return v.Input }
+
 // __updateCredentialInput is used internally by genqlient
 type __updateCredentialInput struct {
 	Id    string                `json:"id"`
@@ -203,6 +885,18 @@ func (v *__updateCredentialInput) GetId() string { return v.Id }
 // GetInput returns __updateCredentialInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateCredentialInput) GetInput() UpdateCredentialInput { return v.Input }
 
+// __updateDatabaseAccessInput is used internally by genqlient
+type __updateDatabaseAccessInput struct {
+	Id    string                    `json:"id"`
+	Input UpdateDatabaseAccessInput `json:"input"`
+}
+
+// GetId returns __updateDatabaseAccessInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateDatabaseAccessInput) GetId() string { return v.Id }
+
+// GetInput returns __updateDatabaseAccessInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateDatabaseAccessInput) GetInput() UpdateDatabaseAccessInput { return v.Input }
+
 // __updateDatabaseInput is used internally by genqlient
 type __updateDatabaseInput struct {
 	Id    string              `json:"id"`
@@ -215,12 +909,120 @@ func (v *__updateDatabaseInput) GetId() string { return v.Id }
 // GetInput returns __updateDatabaseInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateDatabaseInput) GetInput() UpdateDatabaseInput { return v.Input }
 
-// createCredentialCreateCredentialCreateCredentialResult includes the requested fields of the GraphQL type CreateCredentialResult.
-// The GraphQL type's documentation follows.
-//
-// The result of the :create_credential mutation
-type createCredentialCreateCredentialCreateCredentialResult struct {
-	// The successful result of the mutation
+// __updateReviewPolicyInput is used internally by genqlient
+type __updateReviewPolicyInput struct {
+	Id    string                  `json:"id"`
+	Input UpdateReviewPolicyInput `json:"input"`
+}
+
+// GetId returns __updateReviewPolicyInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateReviewPolicyInput) GetId() string { return v.Id }
+
+// GetInput returns __updateReviewPolicyInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateReviewPolicyInput) GetInput() UpdateReviewPolicyInput { return v.Input }
+
+// __updateTeamInput is used internally by genqlient
+type __updateTeamInput struct {
+	Id    string          `json:"id"`
+	Input UpdateTeamInput `json:"input"`
+}
+
+// GetId returns __updateTeamInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamInput) GetId() string { return v.Id }
+
+// GetInput returns __updateTeamInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTeamInput) GetInput() UpdateTeamInput { return v.Input }
+
+// __updateUserInput is used internally by genqlient
+type __updateUserInput struct {
+	Id    string          `json:"id"`
+	Input UpdateUserInput `json:"input"`
+}
+
+// GetId returns __updateUserInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateUserInput) GetId() string { return v.Id }
+
+// GetInput returns __updateUserInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateUserInput) GetInput() UpdateUserInput { return v.Input }
+
+// createApiKeyCreateApiKeyCreateApiKeyResult includes the requested fields of the GraphQL type CreateApiKeyResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :create_api_key mutation
+type createApiKeyCreateApiKeyCreateApiKeyResult struct {
+	// The successful result of the mutation
+	Result createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns createApiKeyCreateApiKeyCreateApiKeyResult.Result, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResult) GetResult() createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey {
+	return v.Result
+}
+
+// GetErrors returns createApiKeyCreateApiKeyCreateApiKeyResult.Errors, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResult) GetErrors() []createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError {
+	return v.Errors
+}
+
+// createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey includes the requested fields of the GraphQL type ApiKey.
+type createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey struct {
+	Id string `json:"id"`
+	// The secret key, only returned by the create and rotate mutations
+	Secret string `json:"secret"`
+}
+
+// GetId returns createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey.Id, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey) GetId() string { return v.Id }
+
+// GetSecret returns createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey.Secret, and is useful for accessing the field via an interface.
+func (v *createApiKeyCreateApiKeyCreateApiKeyResultResultApiKey) GetSecret() string { return v.Secret }
+
+// createApiKeyResponse is returned by createApiKey on success.
+type createApiKeyResponse struct {
+	CreateApiKey createApiKeyCreateApiKeyCreateApiKeyResult `json:"createApiKey"`
+}
+
+// GetCreateApiKey returns createApiKeyResponse.CreateApiKey, and is useful for accessing the field via an interface.
+func (v *createApiKeyResponse) GetCreateApiKey() createApiKeyCreateApiKeyCreateApiKeyResult {
+	return v.CreateApiKey
+}
+
+// createCredentialCreateCredentialCreateCredentialResult includes the requested fields of the GraphQL type CreateCredentialResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :create_credential mutation
+type createCredentialCreateCredentialCreateCredentialResult struct {
+	// The successful result of the mutation
 	Result createCredentialCreateCredentialCreateCredentialResultResultCredential `json:"result"`
 	// Any errors generated, if the mutation failed
 	Errors []createCredentialCreateCredentialCreateCredentialResultErrorsMutationError `json:"errors"`
@@ -245,6 +1047,8 @@ type createCredentialCreateCredentialCreateCredentialResultErrorsMutationError s
 	Code string `json:"code"`
 	// The human readable error message
 	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
 }
 
 // GetCode returns createCredentialCreateCredentialCreateCredentialResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
@@ -257,9 +1061,15 @@ func (v *createCredentialCreateCredentialCreateCredentialResultErrorsMutationErr
 	return v.Message
 }
 
+// GetFields returns createCredentialCreateCredentialCreateCredentialResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createCredentialCreateCredentialCreateCredentialResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
 // createCredentialCreateCredentialCreateCredentialResultResultCredential includes the requested fields of the GraphQL type Credential.
 type createCredentialCreateCredentialCreateCredentialResultResultCredential struct {
-	Id string `json:"id"`
+	Id        string `json:"id"`
+	UpdatedAt string `json:"updatedAt"`
 }
 
 // GetId returns createCredentialCreateCredentialCreateCredentialResultResultCredential.Id, and is useful for accessing the field via an interface.
@@ -267,6 +1077,11 @@ func (v *createCredentialCreateCredentialCreateCredentialResultResultCredential)
 	return v.Id
 }
 
+// GetUpdatedAt returns createCredentialCreateCredentialCreateCredentialResultResultCredential.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *createCredentialCreateCredentialCreateCredentialResultResultCredential) GetUpdatedAt() string {
+	return v.UpdatedAt
+}
+
 // createCredentialResponse is returned by createCredential on success.
 type createCredentialResponse struct {
 	CreateCredential createCredentialCreateCredentialCreateCredentialResult `json:"createCredential"`
@@ -277,6 +1092,75 @@ func (v *createCredentialResponse) GetCreateCredential() createCredentialCreateC
 	return v.CreateCredential
 }
 
+// createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult includes the requested fields of the GraphQL type CreateDatabaseAccessResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :create_database_access mutation
+type createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult struct {
+	// The successful result of the mutation
+	Result createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult.Result, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult) GetResult() createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess {
+	return v.Result
+}
+
+// GetErrors returns createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult.Errors, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult) GetErrors() []createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError {
+	return v.Errors
+}
+
+// createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess includes the requested fields of the GraphQL type DatabaseAccess.
+type createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess struct {
+	Id string `json:"id"`
+}
+
+// GetId returns createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess.Id, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess) GetId() string {
+	return v.Id
+}
+
+// createDatabaseAccessResponse is returned by createDatabaseAccess on success.
+type createDatabaseAccessResponse struct {
+	CreateDatabaseAccess createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult `json:"createDatabaseAccess"`
+}
+
+// GetCreateDatabaseAccess returns createDatabaseAccessResponse.CreateDatabaseAccess, and is useful for accessing the field via an interface.
+func (v *createDatabaseAccessResponse) GetCreateDatabaseAccess() createDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult {
+	return v.CreateDatabaseAccess
+}
+
 // createDatabaseCreateDatabaseCreateDatabaseResult includes the requested fields of the GraphQL type CreateDatabaseResult.
 // The GraphQL type's documentation follows.
 //
@@ -307,6 +1191,8 @@ type createDatabaseCreateDatabaseCreateDatabaseResultErrorsMutationError struct
 	Code string `json:"code"`
 	// The human readable error message
 	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
 }
 
 // GetCode returns createDatabaseCreateDatabaseCreateDatabaseResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
@@ -319,14 +1205,25 @@ func (v *createDatabaseCreateDatabaseCreateDatabaseResultErrorsMutationError) Ge
 	return v.Message
 }
 
+// GetFields returns createDatabaseCreateDatabaseCreateDatabaseResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createDatabaseCreateDatabaseCreateDatabaseResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
 // createDatabaseCreateDatabaseCreateDatabaseResultResultDatabase includes the requested fields of the GraphQL type Database.
 type createDatabaseCreateDatabaseCreateDatabaseResultResultDatabase struct {
-	Id string `json:"id"`
+	Id        string `json:"id"`
+	UpdatedAt string `json:"updatedAt"`
 }
 
 // GetId returns createDatabaseCreateDatabaseCreateDatabaseResultResultDatabase.Id, and is useful for accessing the field via an interface.
 func (v *createDatabaseCreateDatabaseCreateDatabaseResultResultDatabase) GetId() string { return v.Id }
 
+// GetUpdatedAt returns createDatabaseCreateDatabaseCreateDatabaseResultResultDatabase.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *createDatabaseCreateDatabaseCreateDatabaseResultResultDatabase) GetUpdatedAt() string {
+	return v.UpdatedAt
+}
+
 // createDatabaseResponse is returned by createDatabase on success.
 type createDatabaseResponse struct {
 	CreateDatabase createDatabaseCreateDatabaseCreateDatabaseResult `json:"createDatabase"`
@@ -337,360 +1234,3469 @@ func (v *createDatabaseResponse) GetCreateDatabase() createDatabaseCreateDatabas
 	return v.CreateDatabase
 }
 
-// deleteCredentialDeleteCredentialDeleteCredentialResult includes the requested fields of the GraphQL type DeleteCredentialResult.
+// createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult includes the requested fields of the GraphQL type CreateReviewPolicyResult.
 // The GraphQL type's documentation follows.
 //
-// The result of the :delete_credential mutation
-type deleteCredentialDeleteCredentialDeleteCredentialResult struct {
-	// The record that was successfully deleted
-	Result deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential `json:"result"`
+// The result of the :create_review_policy mutation
+type createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult struct {
+	// The successful result of the mutation
+	Result createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy `json:"result"`
 	// Any errors generated, if the mutation failed
-	Errors []deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError `json:"errors"`
+	Errors []createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError `json:"errors"`
 }
 
-// GetResult returns deleteCredentialDeleteCredentialDeleteCredentialResult.Result, and is useful for accessing the field via an interface.
-func (v *deleteCredentialDeleteCredentialDeleteCredentialResult) GetResult() deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential {
+// GetResult returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult.Result, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult) GetResult() createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy {
 	return v.Result
 }
 
-// GetErrors returns deleteCredentialDeleteCredentialDeleteCredentialResult.Errors, and is useful for accessing the field via an interface.
-func (v *deleteCredentialDeleteCredentialDeleteCredentialResult) GetErrors() []deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError {
+// GetErrors returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult.Errors, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult) GetErrors() []createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError {
 	return v.Errors
 }
 
-// deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
 // The GraphQL type's documentation follows.
 //
 // An error generated by a failed mutation
-type deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError struct {
+type createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError struct {
 	// An error code for the given error
 	Code string `json:"code"`
 	// The human readable error message
 	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
 }
 
-// GetCode returns deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
-func (v *deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError) GetCode() string {
+// GetCode returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError) GetCode() string {
 	return v.Code
 }
 
-// GetMessage returns deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
-func (v *deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError) GetMessage() string {
+// GetMessage returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError) GetMessage() string {
 	return v.Message
 }
 
-// deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential includes the requested fields of the GraphQL type Credential.
-type deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential struct {
+// GetFields returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy includes the requested fields of the GraphQL type ReviewPolicy.
+type createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy struct {
 	Id string `json:"id"`
+	// The number of users who can review queries under this policy
+	ReviewerCount int `json:"reviewerCount"`
 }
 
-// GetId returns deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential.Id, and is useful for accessing the field via an interface.
-func (v *deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential) GetId() string {
+// GetId returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy.Id, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy) GetId() string {
 	return v.Id
 }
 
-// deleteCredentialResponse is returned by deleteCredential on success.
-type deleteCredentialResponse struct {
-	DeleteCredential deleteCredentialDeleteCredentialDeleteCredentialResult `json:"deleteCredential"`
+// GetReviewerCount returns createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy.ReviewerCount, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy) GetReviewerCount() int {
+	return v.ReviewerCount
 }
 
-// GetDeleteCredential returns deleteCredentialResponse.DeleteCredential, and is useful for accessing the field via an interface.
-func (v *deleteCredentialResponse) GetDeleteCredential() deleteCredentialDeleteCredentialDeleteCredentialResult {
-	return v.DeleteCredential
+// createReviewPolicyResponse is returned by createReviewPolicy on success.
+type createReviewPolicyResponse struct {
+	CreateReviewPolicy createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult `json:"createReviewPolicy"`
 }
 
-// deleteDatabaseDeleteDatabaseDeleteDatabaseResult includes the requested fields of the GraphQL type DeleteDatabaseResult.
+// GetCreateReviewPolicy returns createReviewPolicyResponse.CreateReviewPolicy, and is useful for accessing the field via an interface.
+func (v *createReviewPolicyResponse) GetCreateReviewPolicy() createReviewPolicyCreateReviewPolicyCreateReviewPolicyResult {
+	return v.CreateReviewPolicy
+}
+
+// createTeamCreateTeamCreateTeamResult includes the requested fields of the GraphQL type CreateTeamResult.
 // The GraphQL type's documentation follows.
 //
-// The result of the :delete_database mutation
-type deleteDatabaseDeleteDatabaseDeleteDatabaseResult struct {
-	// The record that was successfully deleted
-	Result deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase `json:"result"`
+// The result of the :create_team mutation
+type createTeamCreateTeamCreateTeamResult struct {
+	// The successful result of the mutation
+	Result createTeamCreateTeamCreateTeamResultResultTeam `json:"result"`
 	// Any errors generated, if the mutation failed
-	Errors []deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError `json:"errors"`
+	Errors []createTeamCreateTeamCreateTeamResultErrorsMutationError `json:"errors"`
 }
 
-// GetResult returns deleteDatabaseDeleteDatabaseDeleteDatabaseResult.Result, and is useful for accessing the field via an interface.
-func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResult) GetResult() deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase {
+// GetResult returns createTeamCreateTeamCreateTeamResult.Result, and is useful for accessing the field via an interface.
+func (v *createTeamCreateTeamCreateTeamResult) GetResult() createTeamCreateTeamCreateTeamResultResultTeam {
 	return v.Result
 }
 
-// GetErrors returns deleteDatabaseDeleteDatabaseDeleteDatabaseResult.Errors, and is useful for accessing the field via an interface.
-func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResult) GetErrors() []deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError {
+// GetErrors returns createTeamCreateTeamCreateTeamResult.Errors, and is useful for accessing the field via an interface.
+func (v *createTeamCreateTeamCreateTeamResult) GetErrors() []createTeamCreateTeamCreateTeamResultErrorsMutationError {
 	return v.Errors
 }
 
-// deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// createTeamCreateTeamCreateTeamResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
 // The GraphQL type's documentation follows.
 //
 // An error generated by a failed mutation
-type deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError struct {
+type createTeamCreateTeamCreateTeamResultErrorsMutationError struct {
 	// An error code for the given error
 	Code string `json:"code"`
 	// The human readable error message
 	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
 }
 
-// GetCode returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
-func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError) GetCode() string {
-	return v.Code
-}
+// GetCode returns createTeamCreateTeamCreateTeamResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *createTeamCreateTeamCreateTeamResultErrorsMutationError) GetCode() string { return v.Code }
 
-// GetMessage returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
-func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError) GetMessage() string {
+// GetMessage returns createTeamCreateTeamCreateTeamResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *createTeamCreateTeamCreateTeamResultErrorsMutationError) GetMessage() string {
 	return v.Message
 }
 
-// deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase includes the requested fields of the GraphQL type Database.
-type deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase struct {
+// GetFields returns createTeamCreateTeamCreateTeamResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createTeamCreateTeamCreateTeamResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// createTeamCreateTeamCreateTeamResultResultTeam includes the requested fields of the GraphQL type Team.
+type createTeamCreateTeamCreateTeamResultResultTeam struct {
 	Id string `json:"id"`
 }
 
-// GetId returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase.Id, and is useful for accessing the field via an interface.
-func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase) GetId() string { return v.Id }
+// GetId returns createTeamCreateTeamCreateTeamResultResultTeam.Id, and is useful for accessing the field via an interface.
+func (v *createTeamCreateTeamCreateTeamResultResultTeam) GetId() string { return v.Id }
 
-// deleteDatabaseResponse is returned by deleteDatabase on success.
-type deleteDatabaseResponse struct {
-	DeleteDatabase deleteDatabaseDeleteDatabaseDeleteDatabaseResult `json:"deleteDatabase"`
+// createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult includes the requested fields of the GraphQL type CreateTeamMembershipResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :create_team_membership mutation
+type createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult struct {
+	// The successful result of the mutation
+	Result createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError `json:"errors"`
 }
 
-// GetDeleteDatabase returns deleteDatabaseResponse.DeleteDatabase, and is useful for accessing the field via an interface.
-func (v *deleteDatabaseResponse) GetDeleteDatabase() deleteDatabaseDeleteDatabaseDeleteDatabaseResult {
-	return v.DeleteDatabase
+// GetResult returns createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult.Result, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult) GetResult() createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership {
+	return v.Result
 }
 
-// getCredentialCredential includes the requested fields of the GraphQL type Credential.
-type getCredentialCredential struct {
-	Id              string                          `json:"id"`
-	Description     string                          `json:"description"`
-	Username        string                          `json:"username"`
-	ReviewsRequired int                             `json:"reviewsRequired"`
-	Database        getCredentialCredentialDatabase `json:"database"`
+// GetErrors returns createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult.Errors, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult) GetErrors() []createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError {
+	return v.Errors
 }
 
-// GetId returns getCredentialCredential.Id, and is useful for accessing the field via an interface.
-func (v *getCredentialCredential) GetId() string { return v.Id }
-
-// GetDescription returns getCredentialCredential.Description, and is useful for accessing the field via an interface.
-func (v *getCredentialCredential) GetDescription() string { return v.Description }
+// createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
 
-// GetUsername returns getCredentialCredential.Username, and is useful for accessing the field via an interface.
-func (v *getCredentialCredential) GetUsername() string { return v.Username }
+// GetCode returns createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
 
-// GetReviewsRequired returns getCredentialCredential.ReviewsRequired, and is useful for accessing the field via an interface.
-func (v *getCredentialCredential) GetReviewsRequired() int { return v.ReviewsRequired }
+// GetMessage returns createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
 
-// GetDatabase returns getCredentialCredential.Database, and is useful for accessing the field via an interface.
-func (v *getCredentialCredential) GetDatabase() getCredentialCredentialDatabase { return v.Database }
+// GetFields returns createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
 
-// getCredentialCredentialDatabase includes the requested fields of the GraphQL type Database.
-type getCredentialCredentialDatabase struct {
+// createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+type createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership struct {
 	Id string `json:"id"`
 }
 
-// GetId returns getCredentialCredentialDatabase.Id, and is useful for accessing the field via an interface.
-func (v *getCredentialCredentialDatabase) GetId() string { return v.Id }
-
-// getCredentialResponse is returned by getCredential on success.
-type getCredentialResponse struct {
-	Credential getCredentialCredential `json:"credential"`
+// GetId returns createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership) GetId() string {
+	return v.Id
 }
 
-// GetCredential returns getCredentialResponse.Credential, and is useful for accessing the field via an interface.
-func (v *getCredentialResponse) GetCredential() getCredentialCredential { return v.Credential }
-
-// getDatabaseDatabase includes the requested fields of the GraphQL type Database.
-type getDatabaseDatabase struct {
-	Id             string          `json:"id"`
-	Name           string          `json:"name"`
-	Adapter        DatabaseAdapter `json:"adapter"`
-	Hostname       string          `json:"hostname"`
-	Database       string          `json:"database"`
-	Ssl            bool            `json:"ssl"`
-	RestrictAccess bool            `json:"restrictAccess"`
+// createTeamMembershipResponse is returned by createTeamMembership on success.
+type createTeamMembershipResponse struct {
+	CreateTeamMembership createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult `json:"createTeamMembership"`
 }
 
-// GetId returns getDatabaseDatabase.Id, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetId() string { return v.Id }
-
-// GetName returns getDatabaseDatabase.Name, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetName() string { return v.Name }
-
-// GetAdapter returns getDatabaseDatabase.Adapter, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetAdapter() DatabaseAdapter { return v.Adapter }
-
-// GetHostname returns getDatabaseDatabase.Hostname, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetHostname() string { return v.Hostname }
-
-// GetDatabase returns getDatabaseDatabase.Database, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetDatabase() string { return v.Database }
-
-// GetSsl returns getDatabaseDatabase.Ssl, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetSsl() bool { return v.Ssl }
-
-// GetRestrictAccess returns getDatabaseDatabase.RestrictAccess, and is useful for accessing the field via an interface.
-func (v *getDatabaseDatabase) GetRestrictAccess() bool { return v.RestrictAccess }
-
-// getDatabaseResponse is returned by getDatabase on success.
-type getDatabaseResponse struct {
-	Database getDatabaseDatabase `json:"database"`
+// GetCreateTeamMembership returns createTeamMembershipResponse.CreateTeamMembership, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipResponse) GetCreateTeamMembership() createTeamMembershipCreateTeamMembershipCreateTeamMembershipResult {
+	return v.CreateTeamMembership
 }
 
-// GetDatabase returns getDatabaseResponse.Database, and is useful for accessing the field via an interface.
-func (v *getDatabaseResponse) GetDatabase() getDatabaseDatabase { return v.Database }
-
-// updateCredentialResponse is returned by updateCredential on success.
-type updateCredentialResponse struct {
-	UpdateCredential updateCredentialUpdateCredentialUpdateCredentialResult `json:"updateCredential"`
+// createTeamResponse is returned by createTeam on success.
+type createTeamResponse struct {
+	CreateTeam createTeamCreateTeamCreateTeamResult `json:"createTeam"`
 }
 
-// GetUpdateCredential returns updateCredentialResponse.UpdateCredential, and is useful for accessing the field via an interface.
-func (v *updateCredentialResponse) GetUpdateCredential() updateCredentialUpdateCredentialUpdateCredentialResult {
-	return v.UpdateCredential
+// GetCreateTeam returns createTeamResponse.CreateTeam, and is useful for accessing the field via an interface.
+func (v *createTeamResponse) GetCreateTeam() createTeamCreateTeamCreateTeamResult {
+	return v.CreateTeam
 }
 
-// updateCredentialUpdateCredentialUpdateCredentialResult includes the requested fields of the GraphQL type UpdateCredentialResult.
+// createUserCreateUserCreateUserResult includes the requested fields of the GraphQL type CreateUserResult.
 // The GraphQL type's documentation follows.
 //
-// The result of the :update_credential mutation
-type updateCredentialUpdateCredentialUpdateCredentialResult struct {
+// The result of the :create_user mutation
+type createUserCreateUserCreateUserResult struct {
 	// The successful result of the mutation
-	Result updateCredentialUpdateCredentialUpdateCredentialResultResultCredential `json:"result"`
+	Result createUserCreateUserCreateUserResultResultUser `json:"result"`
 	// Any errors generated, if the mutation failed
-	Errors []updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError `json:"errors"`
+	Errors []createUserCreateUserCreateUserResultErrorsMutationError `json:"errors"`
 }
 
-// GetResult returns updateCredentialUpdateCredentialUpdateCredentialResult.Result, and is useful for accessing the field via an interface.
-func (v *updateCredentialUpdateCredentialUpdateCredentialResult) GetResult() updateCredentialUpdateCredentialUpdateCredentialResultResultCredential {
+// GetResult returns createUserCreateUserCreateUserResult.Result, and is useful for accessing the field via an interface.
+func (v *createUserCreateUserCreateUserResult) GetResult() createUserCreateUserCreateUserResultResultUser {
 	return v.Result
 }
 
-// GetErrors returns updateCredentialUpdateCredentialUpdateCredentialResult.Errors, and is useful for accessing the field via an interface.
-func (v *updateCredentialUpdateCredentialUpdateCredentialResult) GetErrors() []updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError {
+// GetErrors returns createUserCreateUserCreateUserResult.Errors, and is useful for accessing the field via an interface.
+func (v *createUserCreateUserCreateUserResult) GetErrors() []createUserCreateUserCreateUserResultErrorsMutationError {
 	return v.Errors
 }
 
-// updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// createUserCreateUserCreateUserResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
 // The GraphQL type's documentation follows.
 //
 // An error generated by a failed mutation
-type updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError struct {
+type createUserCreateUserCreateUserResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns createUserCreateUserCreateUserResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *createUserCreateUserCreateUserResultErrorsMutationError) GetCode() string { return v.Code }
+
+// GetMessage returns createUserCreateUserCreateUserResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *createUserCreateUserCreateUserResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns createUserCreateUserCreateUserResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *createUserCreateUserCreateUserResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// createUserCreateUserCreateUserResultResultUser includes the requested fields of the GraphQL type User.
+type createUserCreateUserCreateUserResultResultUser struct {
+	Id string `json:"id"`
+}
+
+// GetId returns createUserCreateUserCreateUserResultResultUser.Id, and is useful for accessing the field via an interface.
+func (v *createUserCreateUserCreateUserResultResultUser) GetId() string { return v.Id }
+
+// createUserResponse is returned by createUser on success.
+type createUserResponse struct {
+	CreateUser createUserCreateUserCreateUserResult `json:"createUser"`
+}
+
+// GetCreateUser returns createUserResponse.CreateUser, and is useful for accessing the field via an interface.
+func (v *createUserResponse) GetCreateUser() createUserCreateUserCreateUserResult {
+	return v.CreateUser
+}
+
+// deleteApiKeyDeleteApiKeyDeleteApiKeyResult includes the requested fields of the GraphQL type DeleteApiKeyResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_api_key mutation
+type deleteApiKeyDeleteApiKeyDeleteApiKeyResult struct {
+	// The record that was successfully deleted
+	Result deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteApiKeyDeleteApiKeyDeleteApiKeyResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyDeleteApiKeyDeleteApiKeyResult) GetResult() deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey {
+	return v.Result
+}
+
+// GetErrors returns deleteApiKeyDeleteApiKeyDeleteApiKeyResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyDeleteApiKeyDeleteApiKeyResult) GetErrors() []deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyDeleteApiKeyDeleteApiKeyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey includes the requested fields of the GraphQL type ApiKey.
+type deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey.Id, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey) GetId() string { return v.Id }
+
+// deleteApiKeyResponse is returned by deleteApiKey on success.
+type deleteApiKeyResponse struct {
+	DeleteApiKey deleteApiKeyDeleteApiKeyDeleteApiKeyResult `json:"deleteApiKey"`
+}
+
+// GetDeleteApiKey returns deleteApiKeyResponse.DeleteApiKey, and is useful for accessing the field via an interface.
+func (v *deleteApiKeyResponse) GetDeleteApiKey() deleteApiKeyDeleteApiKeyDeleteApiKeyResult {
+	return v.DeleteApiKey
+}
+
+// deleteCredentialDeleteCredentialDeleteCredentialResult includes the requested fields of the GraphQL type DeleteCredentialResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_credential mutation
+type deleteCredentialDeleteCredentialDeleteCredentialResult struct {
+	// The record that was successfully deleted
+	Result deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteCredentialDeleteCredentialDeleteCredentialResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteCredentialDeleteCredentialDeleteCredentialResult) GetResult() deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential {
+	return v.Result
+}
+
+// GetErrors returns deleteCredentialDeleteCredentialDeleteCredentialResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteCredentialDeleteCredentialDeleteCredentialResult) GetErrors() []deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteCredentialDeleteCredentialDeleteCredentialResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential includes the requested fields of the GraphQL type Credential.
+type deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential.Id, and is useful for accessing the field via an interface.
+func (v *deleteCredentialDeleteCredentialDeleteCredentialResultResultCredential) GetId() string {
+	return v.Id
+}
+
+// deleteCredentialResponse is returned by deleteCredential on success.
+type deleteCredentialResponse struct {
+	DeleteCredential deleteCredentialDeleteCredentialDeleteCredentialResult `json:"deleteCredential"`
+}
+
+// GetDeleteCredential returns deleteCredentialResponse.DeleteCredential, and is useful for accessing the field via an interface.
+func (v *deleteCredentialResponse) GetDeleteCredential() deleteCredentialDeleteCredentialDeleteCredentialResult {
+	return v.DeleteCredential
+}
+
+// deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult includes the requested fields of the GraphQL type DeleteDatabaseAccessResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_database_access mutation
+type deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult struct {
+	// The record that was successfully deleted
+	Result deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult) GetResult() deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess {
+	return v.Result
+}
+
+// GetErrors returns deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult) GetErrors() []deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess includes the requested fields of the GraphQL type DatabaseAccess.
+type deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess.Id, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResultResultDatabaseAccess) GetId() string {
+	return v.Id
+}
+
+// deleteDatabaseAccessResponse is returned by deleteDatabaseAccess on success.
+type deleteDatabaseAccessResponse struct {
+	DeleteDatabaseAccess deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult `json:"deleteDatabaseAccess"`
+}
+
+// GetDeleteDatabaseAccess returns deleteDatabaseAccessResponse.DeleteDatabaseAccess, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseAccessResponse) GetDeleteDatabaseAccess() deleteDatabaseAccessDeleteDatabaseAccessDeleteDatabaseAccessResult {
+	return v.DeleteDatabaseAccess
+}
+
+// deleteDatabaseDeleteDatabaseDeleteDatabaseResult includes the requested fields of the GraphQL type DeleteDatabaseResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_database mutation
+type deleteDatabaseDeleteDatabaseDeleteDatabaseResult struct {
+	// The record that was successfully deleted
+	Result deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteDatabaseDeleteDatabaseDeleteDatabaseResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResult) GetResult() deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase {
+	return v.Result
+}
+
+// GetErrors returns deleteDatabaseDeleteDatabaseDeleteDatabaseResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResult) GetErrors() []deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError struct {
 	// An error code for the given error
 	Code string `json:"code"`
 	// The human readable error message
 	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
 }
 
-// GetCode returns updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
-func (v *updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError) GetCode() string {
-	return v.Code
-}
+// GetCode returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase includes the requested fields of the GraphQL type Database.
+type deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase.Id, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase) GetId() string { return v.Id }
+
+// deleteDatabaseResponse is returned by deleteDatabase on success.
+type deleteDatabaseResponse struct {
+	DeleteDatabase deleteDatabaseDeleteDatabaseDeleteDatabaseResult `json:"deleteDatabase"`
+}
+
+// GetDeleteDatabase returns deleteDatabaseResponse.DeleteDatabase, and is useful for accessing the field via an interface.
+func (v *deleteDatabaseResponse) GetDeleteDatabase() deleteDatabaseDeleteDatabaseDeleteDatabaseResult {
+	return v.DeleteDatabase
+}
+
+// deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult includes the requested fields of the GraphQL type DeleteReviewPolicyResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_review_policy mutation
+type deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult struct {
+	// The record that was successfully deleted
+	Result deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult) GetResult() deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy {
+	return v.Result
+}
+
+// GetErrors returns deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult) GetErrors() []deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy includes the requested fields of the GraphQL type ReviewPolicy.
+type deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy.Id, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy) GetId() string {
+	return v.Id
+}
+
+// deleteReviewPolicyResponse is returned by deleteReviewPolicy on success.
+type deleteReviewPolicyResponse struct {
+	DeleteReviewPolicy deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult `json:"deleteReviewPolicy"`
+}
+
+// GetDeleteReviewPolicy returns deleteReviewPolicyResponse.DeleteReviewPolicy, and is useful for accessing the field via an interface.
+func (v *deleteReviewPolicyResponse) GetDeleteReviewPolicy() deleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult {
+	return v.DeleteReviewPolicy
+}
+
+// deleteTeamDeleteTeamDeleteTeamResult includes the requested fields of the GraphQL type DeleteTeamResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_team mutation
+type deleteTeamDeleteTeamDeleteTeamResult struct {
+	// The record that was successfully deleted
+	Result deleteTeamDeleteTeamDeleteTeamResultResultTeam `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteTeamDeleteTeamDeleteTeamResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteTeamDeleteTeamDeleteTeamResult) GetResult() deleteTeamDeleteTeamDeleteTeamResultResultTeam {
+	return v.Result
+}
+
+// GetErrors returns deleteTeamDeleteTeamDeleteTeamResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteTeamDeleteTeamDeleteTeamResult) GetErrors() []deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError) GetCode() string { return v.Code }
+
+// GetMessage returns deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteTeamDeleteTeamDeleteTeamResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteTeamDeleteTeamDeleteTeamResultResultTeam includes the requested fields of the GraphQL type Team.
+type deleteTeamDeleteTeamDeleteTeamResultResultTeam struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteTeamDeleteTeamDeleteTeamResultResultTeam.Id, and is useful for accessing the field via an interface.
+func (v *deleteTeamDeleteTeamDeleteTeamResultResultTeam) GetId() string { return v.Id }
+
+// deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult includes the requested fields of the GraphQL type DeleteTeamMembershipResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_team_membership mutation
+type deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult struct {
+	// The record that was successfully deleted
+	Result deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult) GetResult() deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership {
+	return v.Result
+}
+
+// GetErrors returns deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult) GetErrors() []deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+type deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResultResultTeamMembership) GetId() string {
+	return v.Id
+}
+
+// deleteTeamMembershipResponse is returned by deleteTeamMembership on success.
+type deleteTeamMembershipResponse struct {
+	DeleteTeamMembership deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult `json:"deleteTeamMembership"`
+}
+
+// GetDeleteTeamMembership returns deleteTeamMembershipResponse.DeleteTeamMembership, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipResponse) GetDeleteTeamMembership() deleteTeamMembershipDeleteTeamMembershipDeleteTeamMembershipResult {
+	return v.DeleteTeamMembership
+}
+
+// deleteTeamResponse is returned by deleteTeam on success.
+type deleteTeamResponse struct {
+	DeleteTeam deleteTeamDeleteTeamDeleteTeamResult `json:"deleteTeam"`
+}
+
+// GetDeleteTeam returns deleteTeamResponse.DeleteTeam, and is useful for accessing the field via an interface.
+func (v *deleteTeamResponse) GetDeleteTeam() deleteTeamDeleteTeamDeleteTeamResult {
+	return v.DeleteTeam
+}
+
+// deleteUserDeleteUserDeleteUserResult includes the requested fields of the GraphQL type DeleteUserResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :delete_user mutation
+type deleteUserDeleteUserDeleteUserResult struct {
+	// The record that was successfully deleted
+	Result deleteUserDeleteUserDeleteUserResultResultUser `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []deleteUserDeleteUserDeleteUserResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns deleteUserDeleteUserDeleteUserResult.Result, and is useful for accessing the field via an interface.
+func (v *deleteUserDeleteUserDeleteUserResult) GetResult() deleteUserDeleteUserDeleteUserResultResultUser {
+	return v.Result
+}
+
+// GetErrors returns deleteUserDeleteUserDeleteUserResult.Errors, and is useful for accessing the field via an interface.
+func (v *deleteUserDeleteUserDeleteUserResult) GetErrors() []deleteUserDeleteUserDeleteUserResultErrorsMutationError {
+	return v.Errors
+}
+
+// deleteUserDeleteUserDeleteUserResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type deleteUserDeleteUserDeleteUserResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns deleteUserDeleteUserDeleteUserResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *deleteUserDeleteUserDeleteUserResultErrorsMutationError) GetCode() string { return v.Code }
+
+// GetMessage returns deleteUserDeleteUserDeleteUserResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *deleteUserDeleteUserDeleteUserResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns deleteUserDeleteUserDeleteUserResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *deleteUserDeleteUserDeleteUserResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// deleteUserDeleteUserDeleteUserResultResultUser includes the requested fields of the GraphQL type User.
+type deleteUserDeleteUserDeleteUserResultResultUser struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deleteUserDeleteUserDeleteUserResultResultUser.Id, and is useful for accessing the field via an interface.
+func (v *deleteUserDeleteUserDeleteUserResultResultUser) GetId() string { return v.Id }
+
+// deleteUserResponse is returned by deleteUser on success.
+type deleteUserResponse struct {
+	DeleteUser deleteUserDeleteUserDeleteUserResult `json:"deleteUser"`
+}
+
+// GetDeleteUser returns deleteUserResponse.DeleteUser, and is useful for accessing the field via an interface.
+func (v *deleteUserResponse) GetDeleteUser() deleteUserDeleteUserDeleteUserResult {
+	return v.DeleteUser
+}
+
+// findCredentialsByUsernameDatabase includes the requested fields of the GraphQL type Database.
+type findCredentialsByUsernameDatabase struct {
+	Id          string                                                   `json:"id"`
+	Credentials []findCredentialsByUsernameDatabaseCredentialsCredential `json:"credentials"`
+}
+
+// GetId returns findCredentialsByUsernameDatabase.Id, and is useful for accessing the field via an interface.
+func (v *findCredentialsByUsernameDatabase) GetId() string { return v.Id }
+
+// GetCredentials returns findCredentialsByUsernameDatabase.Credentials, and is useful for accessing the field via an interface.
+func (v *findCredentialsByUsernameDatabase) GetCredentials() []findCredentialsByUsernameDatabaseCredentialsCredential {
+	return v.Credentials
+}
+
+// findCredentialsByUsernameDatabaseCredentialsCredential includes the requested fields of the GraphQL type Credential.
+type findCredentialsByUsernameDatabaseCredentialsCredential struct {
+	Id string `json:"id"`
+}
+
+// GetId returns findCredentialsByUsernameDatabaseCredentialsCredential.Id, and is useful for accessing the field via an interface.
+func (v *findCredentialsByUsernameDatabaseCredentialsCredential) GetId() string { return v.Id }
+
+// findCredentialsByUsernameResponse is returned by findCredentialsByUsername on success.
+type findCredentialsByUsernameResponse struct {
+	Database findCredentialsByUsernameDatabase `json:"database"`
+}
+
+// GetDatabase returns findCredentialsByUsernameResponse.Database, and is useful for accessing the field via an interface.
+func (v *findCredentialsByUsernameResponse) GetDatabase() findCredentialsByUsernameDatabase {
+	return v.Database
+}
+
+// findDatabasesByNameListDatabasesDatabase includes the requested fields of the GraphQL type Database.
+type findDatabasesByNameListDatabasesDatabase struct {
+	Id string `json:"id"`
+}
+
+// GetId returns findDatabasesByNameListDatabasesDatabase.Id, and is useful for accessing the field via an interface.
+func (v *findDatabasesByNameListDatabasesDatabase) GetId() string { return v.Id }
+
+// findDatabasesByNameResponse is returned by findDatabasesByName on success.
+type findDatabasesByNameResponse struct {
+	ListDatabases []findDatabasesByNameListDatabasesDatabase `json:"listDatabases"`
+}
+
+// GetListDatabases returns findDatabasesByNameResponse.ListDatabases, and is useful for accessing the field via an interface.
+func (v *findDatabasesByNameResponse) GetListDatabases() []findDatabasesByNameListDatabasesDatabase {
+	return v.ListDatabases
+}
+
+// getApiKeyApiKey includes the requested fields of the GraphQL type ApiKey.
+type getApiKeyApiKey struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// Whether the key can only read, without changing anything
+	ReadOnly bool `json:"readOnly"`
+	// The databases the key is limited to, all databases when empty
+	DatabaseIds []string `json:"databaseIds"`
+	// When the key stops working, as an RFC 3339 timestamp
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// GetId returns getApiKeyApiKey.Id, and is useful for accessing the field via an interface.
+func (v *getApiKeyApiKey) GetId() string { return v.Id }
+
+// GetName returns getApiKeyApiKey.Name, and is useful for accessing the field via an interface.
+func (v *getApiKeyApiKey) GetName() string { return v.Name }
+
+// GetReadOnly returns getApiKeyApiKey.ReadOnly, and is useful for accessing the field via an interface.
+func (v *getApiKeyApiKey) GetReadOnly() bool { return v.ReadOnly }
+
+// GetDatabaseIds returns getApiKeyApiKey.DatabaseIds, and is useful for accessing the field via an interface.
+func (v *getApiKeyApiKey) GetDatabaseIds() []string { return v.DatabaseIds }
+
+// GetExpiresAt returns getApiKeyApiKey.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *getApiKeyApiKey) GetExpiresAt() string { return v.ExpiresAt }
+
+// getApiKeyResponse is returned by getApiKey on success.
+type getApiKeyResponse struct {
+	ApiKey getApiKeyApiKey `json:"apiKey"`
+}
+
+// GetApiKey returns getApiKeyResponse.ApiKey, and is useful for accessing the field via an interface.
+func (v *getApiKeyResponse) GetApiKey() getApiKeyApiKey { return v.ApiKey }
+
+// getCredentialCredential includes the requested fields of the GraphQL type Credential.
+type getCredentialCredential struct {
+	Id              string                          `json:"id"`
+	Description     string                          `json:"description"`
+	Username        string                          `json:"username"`
+	ReviewsRequired int                             `json:"reviewsRequired"`
+	ReviewPolicyId  string                          `json:"reviewPolicyId"`
+	UpdatedAt       string                          `json:"updatedAt"`
+	Database        getCredentialCredentialDatabase `json:"database"`
+}
+
+// GetId returns getCredentialCredential.Id, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetId() string { return v.Id }
+
+// GetDescription returns getCredentialCredential.Description, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetDescription() string { return v.Description }
+
+// GetUsername returns getCredentialCredential.Username, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetUsername() string { return v.Username }
+
+// GetReviewsRequired returns getCredentialCredential.ReviewsRequired, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetReviewsRequired() int { return v.ReviewsRequired }
+
+// GetReviewPolicyId returns getCredentialCredential.ReviewPolicyId, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetReviewPolicyId() string { return v.ReviewPolicyId }
+
+// GetUpdatedAt returns getCredentialCredential.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetUpdatedAt() string { return v.UpdatedAt }
+
+// GetDatabase returns getCredentialCredential.Database, and is useful for accessing the field via an interface.
+func (v *getCredentialCredential) GetDatabase() getCredentialCredentialDatabase { return v.Database }
+
+// getCredentialCredentialDatabase includes the requested fields of the GraphQL type Database.
+type getCredentialCredentialDatabase struct {
+	Id               string                                          `json:"id"`
+	Adapter          DatabaseAdapter                                 `json:"adapter"`
+	Hostname         string                                          `json:"hostname"`
+	Database         string                                          `json:"database"`
+	Ssl              bool                                            `json:"ssl"`
+	PostgresOptions  getCredentialCredentialDatabasePostgresOptions  `json:"postgresOptions"`
+	MysqlOptions     getCredentialCredentialDatabaseMysqlOptions     `json:"mysqlOptions"`
+	SqlserverOptions getCredentialCredentialDatabaseSqlserverOptions `json:"sqlserverOptions"`
+}
+
+// GetId returns getCredentialCredentialDatabase.Id, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetId() string { return v.Id }
+
+// GetAdapter returns getCredentialCredentialDatabase.Adapter, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetAdapter() DatabaseAdapter { return v.Adapter }
+
+// GetHostname returns getCredentialCredentialDatabase.Hostname, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetHostname() string { return v.Hostname }
+
+// GetDatabase returns getCredentialCredentialDatabase.Database, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetDatabase() string { return v.Database }
+
+// GetSsl returns getCredentialCredentialDatabase.Ssl, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetSsl() bool { return v.Ssl }
+
+// GetPostgresOptions returns getCredentialCredentialDatabase.PostgresOptions, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetPostgresOptions() getCredentialCredentialDatabasePostgresOptions {
+	return v.PostgresOptions
+}
+
+// GetMysqlOptions returns getCredentialCredentialDatabase.MysqlOptions, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetMysqlOptions() getCredentialCredentialDatabaseMysqlOptions {
+	return v.MysqlOptions
+}
+
+// GetSqlserverOptions returns getCredentialCredentialDatabase.SqlserverOptions, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabase) GetSqlserverOptions() getCredentialCredentialDatabaseSqlserverOptions {
+	return v.SqlserverOptions
+}
+
+// getCredentialCredentialDatabaseMysqlOptions includes the requested fields of the GraphQL type DatabaseMysqlOptions.
+type getCredentialCredentialDatabaseMysqlOptions struct {
+	Charset   string `json:"charset"`
+	Collation string `json:"collation"`
+}
+
+// GetCharset returns getCredentialCredentialDatabaseMysqlOptions.Charset, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabaseMysqlOptions) GetCharset() string { return v.Charset }
+
+// GetCollation returns getCredentialCredentialDatabaseMysqlOptions.Collation, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabaseMysqlOptions) GetCollation() string { return v.Collation }
+
+// getCredentialCredentialDatabasePostgresOptions includes the requested fields of the GraphQL type DatabasePostgresOptions.
+type getCredentialCredentialDatabasePostgresOptions struct {
+	Sslmode    DatabasePostgresSslmode `json:"sslmode"`
+	SearchPath string                  `json:"searchPath"`
+}
+
+// GetSslmode returns getCredentialCredentialDatabasePostgresOptions.Sslmode, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabasePostgresOptions) GetSslmode() DatabasePostgresSslmode {
+	return v.Sslmode
+}
+
+// GetSearchPath returns getCredentialCredentialDatabasePostgresOptions.SearchPath, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabasePostgresOptions) GetSearchPath() string { return v.SearchPath }
+
+// getCredentialCredentialDatabaseSqlserverOptions includes the requested fields of the GraphQL type DatabaseSqlserverOptions.
+type getCredentialCredentialDatabaseSqlserverOptions struct {
+	TrustServerCertificate bool `json:"trustServerCertificate"`
+}
+
+// GetTrustServerCertificate returns getCredentialCredentialDatabaseSqlserverOptions.TrustServerCertificate, and is useful for accessing the field via an interface.
+func (v *getCredentialCredentialDatabaseSqlserverOptions) GetTrustServerCertificate() bool {
+	return v.TrustServerCertificate
+}
+
+// getCredentialResponse is returned by getCredential on success.
+type getCredentialResponse struct {
+	Credential getCredentialCredential `json:"credential"`
+}
+
+// GetCredential returns getCredentialResponse.Credential, and is useful for accessing the field via an interface.
+func (v *getCredentialResponse) GetCredential() getCredentialCredential { return v.Credential }
+
+// getCurrentApiKeyCurrentApiKey includes the requested fields of the GraphQL type ApiKey.
+type getCurrentApiKeyCurrentApiKey struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// Whether the key can only read, without changing anything
+	ReadOnly bool `json:"readOnly"`
+	// The databases the key is limited to, all databases when empty
+	DatabaseIds []string `json:"databaseIds"`
+	// When the key stops working, as an RFC 3339 timestamp
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// GetId returns getCurrentApiKeyCurrentApiKey.Id, and is useful for accessing the field via an interface.
+func (v *getCurrentApiKeyCurrentApiKey) GetId() string { return v.Id }
+
+// GetName returns getCurrentApiKeyCurrentApiKey.Name, and is useful for accessing the field via an interface.
+func (v *getCurrentApiKeyCurrentApiKey) GetName() string { return v.Name }
+
+// GetReadOnly returns getCurrentApiKeyCurrentApiKey.ReadOnly, and is useful for accessing the field via an interface.
+func (v *getCurrentApiKeyCurrentApiKey) GetReadOnly() bool { return v.ReadOnly }
+
+// GetDatabaseIds returns getCurrentApiKeyCurrentApiKey.DatabaseIds, and is useful for accessing the field via an interface.
+func (v *getCurrentApiKeyCurrentApiKey) GetDatabaseIds() []string { return v.DatabaseIds }
+
+// GetExpiresAt returns getCurrentApiKeyCurrentApiKey.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *getCurrentApiKeyCurrentApiKey) GetExpiresAt() string { return v.ExpiresAt }
+
+// getCurrentApiKeyResponse is returned by getCurrentApiKey on success.
+type getCurrentApiKeyResponse struct {
+	// The API key used to authenticate the request
+	CurrentApiKey getCurrentApiKeyCurrentApiKey `json:"currentApiKey"`
+}
+
+// GetCurrentApiKey returns getCurrentApiKeyResponse.CurrentApiKey, and is useful for accessing the field via an interface.
+func (v *getCurrentApiKeyResponse) GetCurrentApiKey() getCurrentApiKeyCurrentApiKey {
+	return v.CurrentApiKey
+}
+
+// getDatabaseAccessDatabaseAccess includes the requested fields of the GraphQL type DatabaseAccess.
+type getDatabaseAccessDatabaseAccess struct {
+	Id            string   `json:"id"`
+	DatabaseId    string   `json:"databaseId"`
+	UserId        string   `json:"userId"`
+	TeamId        string   `json:"teamId"`
+	CredentialIds []string `json:"credentialIds"`
+}
+
+// GetId returns getDatabaseAccessDatabaseAccess.Id, and is useful for accessing the field via an interface.
+func (v *getDatabaseAccessDatabaseAccess) GetId() string { return v.Id }
+
+// GetDatabaseId returns getDatabaseAccessDatabaseAccess.DatabaseId, and is useful for accessing the field via an interface.
+func (v *getDatabaseAccessDatabaseAccess) GetDatabaseId() string { return v.DatabaseId }
+
+// GetUserId returns getDatabaseAccessDatabaseAccess.UserId, and is useful for accessing the field via an interface.
+func (v *getDatabaseAccessDatabaseAccess) GetUserId() string { return v.UserId }
+
+// GetTeamId returns getDatabaseAccessDatabaseAccess.TeamId, and is useful for accessing the field via an interface.
+func (v *getDatabaseAccessDatabaseAccess) GetTeamId() string { return v.TeamId }
+
+// GetCredentialIds returns getDatabaseAccessDatabaseAccess.CredentialIds, and is useful for accessing the field via an interface.
+func (v *getDatabaseAccessDatabaseAccess) GetCredentialIds() []string { return v.CredentialIds }
+
+// getDatabaseAccessResponse is returned by getDatabaseAccess on success.
+type getDatabaseAccessResponse struct {
+	DatabaseAccess getDatabaseAccessDatabaseAccess `json:"databaseAccess"`
+}
+
+// GetDatabaseAccess returns getDatabaseAccessResponse.DatabaseAccess, and is useful for accessing the field via an interface.
+func (v *getDatabaseAccessResponse) GetDatabaseAccess() getDatabaseAccessDatabaseAccess {
+	return v.DatabaseAccess
+}
+
+// getDatabaseCredentialsDatabase includes the requested fields of the GraphQL type Database.
+type getDatabaseCredentialsDatabase struct {
+	Id          string                                                `json:"id"`
+	Credentials []getDatabaseCredentialsDatabaseCredentialsCredential `json:"credentials"`
+}
+
+// GetId returns getDatabaseCredentialsDatabase.Id, and is useful for accessing the field via an interface.
+func (v *getDatabaseCredentialsDatabase) GetId() string { return v.Id }
+
+// GetCredentials returns getDatabaseCredentialsDatabase.Credentials, and is useful for accessing the field via an interface.
+func (v *getDatabaseCredentialsDatabase) GetCredentials() []getDatabaseCredentialsDatabaseCredentialsCredential {
+	return v.Credentials
+}
+
+// getDatabaseCredentialsDatabaseCredentialsCredential includes the requested fields of the GraphQL type Credential.
+type getDatabaseCredentialsDatabaseCredentialsCredential struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getDatabaseCredentialsDatabaseCredentialsCredential.Id, and is useful for accessing the field via an interface.
+func (v *getDatabaseCredentialsDatabaseCredentialsCredential) GetId() string { return v.Id }
+
+// getDatabaseCredentialsResponse is returned by getDatabaseCredentials on success.
+type getDatabaseCredentialsResponse struct {
+	Database getDatabaseCredentialsDatabase `json:"database"`
+}
+
+// GetDatabase returns getDatabaseCredentialsResponse.Database, and is useful for accessing the field via an interface.
+func (v *getDatabaseCredentialsResponse) GetDatabase() getDatabaseCredentialsDatabase {
+	return v.Database
+}
+
+// getDatabaseDatabase includes the requested fields of the GraphQL type Database.
+type getDatabaseDatabase struct {
+	Id               string                              `json:"id"`
+	Name             string                              `json:"name"`
+	Adapter          DatabaseAdapter                     `json:"adapter"`
+	Hostname         string                              `json:"hostname"`
+	Database         string                              `json:"database"`
+	Ssl              bool                                `json:"ssl"`
+	RestrictAccess   bool                                `json:"restrictAccess"`
+	PostgresOptions  getDatabaseDatabasePostgresOptions  `json:"postgresOptions"`
+	MysqlOptions     getDatabaseDatabaseMysqlOptions     `json:"mysqlOptions"`
+	SqlserverOptions getDatabaseDatabaseSqlserverOptions `json:"sqlserverOptions"`
+	UpdatedAt        string                              `json:"updatedAt"`
+}
+
+// GetId returns getDatabaseDatabase.Id, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetId() string { return v.Id }
+
+// GetName returns getDatabaseDatabase.Name, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetName() string { return v.Name }
+
+// GetAdapter returns getDatabaseDatabase.Adapter, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetAdapter() DatabaseAdapter { return v.Adapter }
+
+// GetHostname returns getDatabaseDatabase.Hostname, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetHostname() string { return v.Hostname }
+
+// GetDatabase returns getDatabaseDatabase.Database, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetDatabase() string { return v.Database }
+
+// GetSsl returns getDatabaseDatabase.Ssl, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetSsl() bool { return v.Ssl }
+
+// GetRestrictAccess returns getDatabaseDatabase.RestrictAccess, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetRestrictAccess() bool { return v.RestrictAccess }
+
+// GetPostgresOptions returns getDatabaseDatabase.PostgresOptions, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetPostgresOptions() getDatabaseDatabasePostgresOptions {
+	return v.PostgresOptions
+}
+
+// GetMysqlOptions returns getDatabaseDatabase.MysqlOptions, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetMysqlOptions() getDatabaseDatabaseMysqlOptions {
+	return v.MysqlOptions
+}
+
+// GetSqlserverOptions returns getDatabaseDatabase.SqlserverOptions, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetSqlserverOptions() getDatabaseDatabaseSqlserverOptions {
+	return v.SqlserverOptions
+}
+
+// GetUpdatedAt returns getDatabaseDatabase.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabase) GetUpdatedAt() string { return v.UpdatedAt }
+
+// getDatabaseDatabaseMysqlOptions includes the requested fields of the GraphQL type DatabaseMysqlOptions.
+type getDatabaseDatabaseMysqlOptions struct {
+	Charset   string `json:"charset"`
+	Collation string `json:"collation"`
+}
+
+// GetCharset returns getDatabaseDatabaseMysqlOptions.Charset, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabaseMysqlOptions) GetCharset() string { return v.Charset }
+
+// GetCollation returns getDatabaseDatabaseMysqlOptions.Collation, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabaseMysqlOptions) GetCollation() string { return v.Collation }
+
+// getDatabaseDatabasePostgresOptions includes the requested fields of the GraphQL type DatabasePostgresOptions.
+type getDatabaseDatabasePostgresOptions struct {
+	Sslmode    DatabasePostgresSslmode `json:"sslmode"`
+	SearchPath string                  `json:"searchPath"`
+}
+
+// GetSslmode returns getDatabaseDatabasePostgresOptions.Sslmode, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabasePostgresOptions) GetSslmode() DatabasePostgresSslmode { return v.Sslmode }
+
+// GetSearchPath returns getDatabaseDatabasePostgresOptions.SearchPath, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabasePostgresOptions) GetSearchPath() string { return v.SearchPath }
+
+// getDatabaseDatabaseSqlserverOptions includes the requested fields of the GraphQL type DatabaseSqlserverOptions.
+type getDatabaseDatabaseSqlserverOptions struct {
+	TrustServerCertificate bool `json:"trustServerCertificate"`
+}
+
+// GetTrustServerCertificate returns getDatabaseDatabaseSqlserverOptions.TrustServerCertificate, and is useful for accessing the field via an interface.
+func (v *getDatabaseDatabaseSqlserverOptions) GetTrustServerCertificate() bool {
+	return v.TrustServerCertificate
+}
+
+// getDatabaseResponse is returned by getDatabase on success.
+type getDatabaseResponse struct {
+	Database getDatabaseDatabase `json:"database"`
+}
+
+// GetDatabase returns getDatabaseResponse.Database, and is useful for accessing the field via an interface.
+func (v *getDatabaseResponse) GetDatabase() getDatabaseDatabase { return v.Database }
+
+// getReviewPolicyResponse is returned by getReviewPolicy on success.
+type getReviewPolicyResponse struct {
+	ReviewPolicy getReviewPolicyReviewPolicy `json:"reviewPolicy"`
+}
+
+// GetReviewPolicy returns getReviewPolicyResponse.ReviewPolicy, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyResponse) GetReviewPolicy() getReviewPolicyReviewPolicy {
+	return v.ReviewPolicy
+}
+
+// getReviewPolicyReviewPolicy includes the requested fields of the GraphQL type ReviewPolicy.
+type getReviewPolicyReviewPolicy struct {
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	ReviewerTeamId    string `json:"reviewerTeamId"`
+	AllowSelfApproval bool   `json:"allowSelfApproval"`
+	ReadOnlyBypass    bool   `json:"readOnlyBypass"`
+	// The number of users who can review queries under this policy
+	ReviewerCount int `json:"reviewerCount"`
+}
+
+// GetId returns getReviewPolicyReviewPolicy.Id, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyReviewPolicy) GetId() string { return v.Id }
+
+// GetName returns getReviewPolicyReviewPolicy.Name, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyReviewPolicy) GetName() string { return v.Name }
+
+// GetReviewerTeamId returns getReviewPolicyReviewPolicy.ReviewerTeamId, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyReviewPolicy) GetReviewerTeamId() string { return v.ReviewerTeamId }
+
+// GetAllowSelfApproval returns getReviewPolicyReviewPolicy.AllowSelfApproval, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyReviewPolicy) GetAllowSelfApproval() bool { return v.AllowSelfApproval }
+
+// GetReadOnlyBypass returns getReviewPolicyReviewPolicy.ReadOnlyBypass, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyReviewPolicy) GetReadOnlyBypass() bool { return v.ReadOnlyBypass }
+
+// GetReviewerCount returns getReviewPolicyReviewPolicy.ReviewerCount, and is useful for accessing the field via an interface.
+func (v *getReviewPolicyReviewPolicy) GetReviewerCount() int { return v.ReviewerCount }
+
+// getTeamMembershipResponse is returned by getTeamMembership on success.
+type getTeamMembershipResponse struct {
+	TeamMembership getTeamMembershipTeamMembership `json:"teamMembership"`
+}
+
+// GetTeamMembership returns getTeamMembershipResponse.TeamMembership, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipResponse) GetTeamMembership() getTeamMembershipTeamMembership {
+	return v.TeamMembership
+}
+
+// getTeamMembershipTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+type getTeamMembershipTeamMembership struct {
+	Id     string `json:"id"`
+	TeamId string `json:"teamId"`
+	UserId string `json:"userId"`
+}
+
+// GetId returns getTeamMembershipTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetId() string { return v.Id }
+
+// GetTeamId returns getTeamMembershipTeamMembership.TeamId, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetTeamId() string { return v.TeamId }
+
+// GetUserId returns getTeamMembershipTeamMembership.UserId, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetUserId() string { return v.UserId }
+
+// getTeamResponse is returned by getTeam on success.
+type getTeamResponse struct {
+	Team getTeamTeam `json:"team"`
+}
+
+// GetTeam returns getTeamResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamResponse) GetTeam() getTeamTeam { return v.Team }
+
+// getTeamTeam includes the requested fields of the GraphQL type Team.
+type getTeamTeam struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetId returns getTeamTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetId() string { return v.Id }
+
+// GetName returns getTeamTeam.Name, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetName() string { return v.Name }
+
+// GetDescription returns getTeamTeam.Description, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDescription() string { return v.Description }
+
+// getUserResponse is returned by getUser on success.
+type getUserResponse struct {
+	User getUserUser `json:"user"`
+}
+
+// GetUser returns getUserResponse.User, and is useful for accessing the field via an interface.
+func (v *getUserResponse) GetUser() getUserUser { return v.User }
+
+// getUserUser includes the requested fields of the GraphQL type User.
+type getUserUser struct {
+	Id    string   `json:"id"`
+	Email string   `json:"email"`
+	Name  string   `json:"name"`
+	Role  UserRole `json:"role"`
+}
+
+// GetId returns getUserUser.Id, and is useful for accessing the field via an interface.
+func (v *getUserUser) GetId() string { return v.Id }
+
+// GetEmail returns getUserUser.Email, and is useful for accessing the field via an interface.
+func (v *getUserUser) GetEmail() string { return v.Email }
+
+// GetName returns getUserUser.Name, and is useful for accessing the field via an interface.
+func (v *getUserUser) GetName() string { return v.Name }
+
+// GetRole returns getUserUser.Role, and is useful for accessing the field via an interface.
+func (v *getUserUser) GetRole() UserRole { return v.Role }
+
+// listAuditEventsListAuditEventsAuditEvent includes the requested fields of the GraphQL type AuditEvent.
+// The GraphQL type's documentation follows.
+//
+// A query run through QueryDesk
+type listAuditEventsListAuditEventsAuditEvent struct {
+	Id           string `json:"id"`
+	DatabaseId   string `json:"databaseId"`
+	CredentialId string `json:"credentialId"`
+	// The user who ran the query
+	UserId string `json:"userId"`
+	// The query that was run
+	Query          string                   `json:"query"`
+	ApprovalStatus AuditEventApprovalStatus `json:"approvalStatus"`
+	// When the query was run, as an RFC 3339 timestamp
+	InsertedAt string `json:"insertedAt"`
+}
+
+// GetId returns listAuditEventsListAuditEventsAuditEvent.Id, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetId() string { return v.Id }
+
+// GetDatabaseId returns listAuditEventsListAuditEventsAuditEvent.DatabaseId, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetDatabaseId() string { return v.DatabaseId }
+
+// GetCredentialId returns listAuditEventsListAuditEventsAuditEvent.CredentialId, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetCredentialId() string { return v.CredentialId }
+
+// GetUserId returns listAuditEventsListAuditEventsAuditEvent.UserId, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetUserId() string { return v.UserId }
+
+// GetQuery returns listAuditEventsListAuditEventsAuditEvent.Query, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetQuery() string { return v.Query }
+
+// GetApprovalStatus returns listAuditEventsListAuditEventsAuditEvent.ApprovalStatus, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetApprovalStatus() AuditEventApprovalStatus {
+	return v.ApprovalStatus
+}
+
+// GetInsertedAt returns listAuditEventsListAuditEventsAuditEvent.InsertedAt, and is useful for accessing the field via an interface.
+func (v *listAuditEventsListAuditEventsAuditEvent) GetInsertedAt() string { return v.InsertedAt }
+
+// listAuditEventsResponse is returned by listAuditEvents on success.
+type listAuditEventsResponse struct {
+	ListAuditEvents []listAuditEventsListAuditEventsAuditEvent `json:"listAuditEvents"`
+}
+
+// GetListAuditEvents returns listAuditEventsResponse.ListAuditEvents, and is useful for accessing the field via an interface.
+func (v *listAuditEventsResponse) GetListAuditEvents() []listAuditEventsListAuditEventsAuditEvent {
+	return v.ListAuditEvents
+}
+
+// listDatabasesListDatabasesDatabase includes the requested fields of the GraphQL type Database.
+type listDatabasesListDatabasesDatabase struct {
+	Id               string                                                    `json:"id"`
+	Name             string                                                    `json:"name"`
+	Adapter          DatabaseAdapter                                           `json:"adapter"`
+	Hostname         string                                                    `json:"hostname"`
+	Database         string                                                    `json:"database"`
+	Ssl              bool                                                      `json:"ssl"`
+	RestrictAccess   bool                                                      `json:"restrictAccess"`
+	PostgresOptions  listDatabasesListDatabasesDatabasePostgresOptions         `json:"postgresOptions"`
+	MysqlOptions     listDatabasesListDatabasesDatabaseMysqlOptions            `json:"mysqlOptions"`
+	SqlserverOptions listDatabasesListDatabasesDatabaseSqlserverOptions        `json:"sqlserverOptions"`
+	Credentials      []listDatabasesListDatabasesDatabaseCredentialsCredential `json:"credentials"`
+}
+
+// GetId returns listDatabasesListDatabasesDatabase.Id, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetId() string { return v.Id }
+
+// GetName returns listDatabasesListDatabasesDatabase.Name, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetName() string { return v.Name }
+
+// GetAdapter returns listDatabasesListDatabasesDatabase.Adapter, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetAdapter() DatabaseAdapter { return v.Adapter }
+
+// GetHostname returns listDatabasesListDatabasesDatabase.Hostname, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetHostname() string { return v.Hostname }
+
+// GetDatabase returns listDatabasesListDatabasesDatabase.Database, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetDatabase() string { return v.Database }
+
+// GetSsl returns listDatabasesListDatabasesDatabase.Ssl, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetSsl() bool { return v.Ssl }
+
+// GetRestrictAccess returns listDatabasesListDatabasesDatabase.RestrictAccess, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetRestrictAccess() bool { return v.RestrictAccess }
+
+// GetPostgresOptions returns listDatabasesListDatabasesDatabase.PostgresOptions, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetPostgresOptions() listDatabasesListDatabasesDatabasePostgresOptions {
+	return v.PostgresOptions
+}
+
+// GetMysqlOptions returns listDatabasesListDatabasesDatabase.MysqlOptions, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetMysqlOptions() listDatabasesListDatabasesDatabaseMysqlOptions {
+	return v.MysqlOptions
+}
+
+// GetSqlserverOptions returns listDatabasesListDatabasesDatabase.SqlserverOptions, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetSqlserverOptions() listDatabasesListDatabasesDatabaseSqlserverOptions {
+	return v.SqlserverOptions
+}
+
+// GetCredentials returns listDatabasesListDatabasesDatabase.Credentials, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabase) GetCredentials() []listDatabasesListDatabasesDatabaseCredentialsCredential {
+	return v.Credentials
+}
+
+// listDatabasesListDatabasesDatabaseCredentialsCredential includes the requested fields of the GraphQL type Credential.
+type listDatabasesListDatabasesDatabaseCredentialsCredential struct {
+	Id              string `json:"id"`
+	Description     string `json:"description"`
+	Username        string `json:"username"`
+	ReviewsRequired int    `json:"reviewsRequired"`
+	ReviewPolicyId  string `json:"reviewPolicyId"`
+}
+
+// GetId returns listDatabasesListDatabasesDatabaseCredentialsCredential.Id, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseCredentialsCredential) GetId() string { return v.Id }
+
+// GetDescription returns listDatabasesListDatabasesDatabaseCredentialsCredential.Description, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseCredentialsCredential) GetDescription() string {
+	return v.Description
+}
+
+// GetUsername returns listDatabasesListDatabasesDatabaseCredentialsCredential.Username, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseCredentialsCredential) GetUsername() string {
+	return v.Username
+}
+
+// GetReviewsRequired returns listDatabasesListDatabasesDatabaseCredentialsCredential.ReviewsRequired, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseCredentialsCredential) GetReviewsRequired() int {
+	return v.ReviewsRequired
+}
+
+// GetReviewPolicyId returns listDatabasesListDatabasesDatabaseCredentialsCredential.ReviewPolicyId, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseCredentialsCredential) GetReviewPolicyId() string {
+	return v.ReviewPolicyId
+}
+
+// listDatabasesListDatabasesDatabaseMysqlOptions includes the requested fields of the GraphQL type DatabaseMysqlOptions.
+type listDatabasesListDatabasesDatabaseMysqlOptions struct {
+	Charset   string `json:"charset"`
+	Collation string `json:"collation"`
+}
+
+// GetCharset returns listDatabasesListDatabasesDatabaseMysqlOptions.Charset, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseMysqlOptions) GetCharset() string { return v.Charset }
+
+// GetCollation returns listDatabasesListDatabasesDatabaseMysqlOptions.Collation, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseMysqlOptions) GetCollation() string { return v.Collation }
+
+// listDatabasesListDatabasesDatabasePostgresOptions includes the requested fields of the GraphQL type DatabasePostgresOptions.
+type listDatabasesListDatabasesDatabasePostgresOptions struct {
+	Sslmode    DatabasePostgresSslmode `json:"sslmode"`
+	SearchPath string                  `json:"searchPath"`
+}
+
+// GetSslmode returns listDatabasesListDatabasesDatabasePostgresOptions.Sslmode, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabasePostgresOptions) GetSslmode() DatabasePostgresSslmode {
+	return v.Sslmode
+}
+
+// GetSearchPath returns listDatabasesListDatabasesDatabasePostgresOptions.SearchPath, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabasePostgresOptions) GetSearchPath() string {
+	return v.SearchPath
+}
+
+// listDatabasesListDatabasesDatabaseSqlserverOptions includes the requested fields of the GraphQL type DatabaseSqlserverOptions.
+type listDatabasesListDatabasesDatabaseSqlserverOptions struct {
+	TrustServerCertificate bool `json:"trustServerCertificate"`
+}
+
+// GetTrustServerCertificate returns listDatabasesListDatabasesDatabaseSqlserverOptions.TrustServerCertificate, and is useful for accessing the field via an interface.
+func (v *listDatabasesListDatabasesDatabaseSqlserverOptions) GetTrustServerCertificate() bool {
+	return v.TrustServerCertificate
+}
+
+// listDatabasesResponse is returned by listDatabases on success.
+type listDatabasesResponse struct {
+	ListDatabases []listDatabasesListDatabasesDatabase `json:"listDatabases"`
+}
+
+// GetListDatabases returns listDatabasesResponse.ListDatabases, and is useful for accessing the field via an interface.
+func (v *listDatabasesResponse) GetListDatabases() []listDatabasesListDatabasesDatabase {
+	return v.ListDatabases
+}
+
+// pingResponse is returned by ping on success.
+type pingResponse struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns pingResponse.Typename, and is useful for accessing the field via an interface.
+func (v *pingResponse) GetTypename() string { return v.Typename }
+
+// rotateApiKeyResponse is returned by rotateApiKey on success.
+type rotateApiKeyResponse struct {
+	RotateApiKey rotateApiKeyRotateApiKeyRotateApiKeyResult `json:"rotateApiKey"`
+}
+
+// GetRotateApiKey returns rotateApiKeyResponse.RotateApiKey, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyResponse) GetRotateApiKey() rotateApiKeyRotateApiKeyRotateApiKeyResult {
+	return v.RotateApiKey
+}
+
+// rotateApiKeyRotateApiKeyRotateApiKeyResult includes the requested fields of the GraphQL type RotateApiKeyResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :rotate_api_key mutation
+type rotateApiKeyRotateApiKeyRotateApiKeyResult struct {
+	// The successful result of the mutation
+	Result rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns rotateApiKeyRotateApiKeyRotateApiKeyResult.Result, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResult) GetResult() rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey {
+	return v.Result
+}
+
+// GetErrors returns rotateApiKeyRotateApiKeyRotateApiKeyResult.Errors, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResult) GetErrors() []rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError {
+	return v.Errors
+}
+
+// rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey includes the requested fields of the GraphQL type ApiKey.
+type rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey struct {
+	Id string `json:"id"`
+	// The secret key, only returned by the create and rotate mutations
+	Secret string `json:"secret"`
+}
+
+// GetId returns rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey.Id, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey) GetId() string { return v.Id }
+
+// GetSecret returns rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey.Secret, and is useful for accessing the field via an interface.
+func (v *rotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey) GetSecret() string { return v.Secret }
+
+// updateApiKeyResponse is returned by updateApiKey on success.
+type updateApiKeyResponse struct {
+	UpdateApiKey updateApiKeyUpdateApiKeyUpdateApiKeyResult `json:"updateApiKey"`
+}
+
+// GetUpdateApiKey returns updateApiKeyResponse.UpdateApiKey, and is useful for accessing the field via an interface.
+func (v *updateApiKeyResponse) GetUpdateApiKey() updateApiKeyUpdateApiKeyUpdateApiKeyResult {
+	return v.UpdateApiKey
+}
+
+// updateApiKeyUpdateApiKeyUpdateApiKeyResult includes the requested fields of the GraphQL type UpdateApiKeyResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_api_key mutation
+type updateApiKeyUpdateApiKeyUpdateApiKeyResult struct {
+	// The successful result of the mutation
+	Result updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateApiKeyUpdateApiKeyUpdateApiKeyResult.Result, and is useful for accessing the field via an interface.
+func (v *updateApiKeyUpdateApiKeyUpdateApiKeyResult) GetResult() updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey {
+	return v.Result
+}
+
+// GetErrors returns updateApiKeyUpdateApiKeyUpdateApiKeyResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateApiKeyUpdateApiKeyUpdateApiKeyResult) GetErrors() []updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateApiKeyUpdateApiKeyUpdateApiKeyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey includes the requested fields of the GraphQL type ApiKey.
+type updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey struct {
+	Id string `json:"id"`
+}
+
+// GetId returns updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey.Id, and is useful for accessing the field via an interface.
+func (v *updateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey) GetId() string { return v.Id }
+
+// updateCredentialResponse is returned by updateCredential on success.
+type updateCredentialResponse struct {
+	UpdateCredential updateCredentialUpdateCredentialUpdateCredentialResult `json:"updateCredential"`
+}
+
+// GetUpdateCredential returns updateCredentialResponse.UpdateCredential, and is useful for accessing the field via an interface.
+func (v *updateCredentialResponse) GetUpdateCredential() updateCredentialUpdateCredentialUpdateCredentialResult {
+	return v.UpdateCredential
+}
+
+// updateCredentialUpdateCredentialUpdateCredentialResult includes the requested fields of the GraphQL type UpdateCredentialResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_credential mutation
+type updateCredentialUpdateCredentialUpdateCredentialResult struct {
+	// The successful result of the mutation
+	Result updateCredentialUpdateCredentialUpdateCredentialResultResultCredential `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateCredentialUpdateCredentialUpdateCredentialResult.Result, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResult) GetResult() updateCredentialUpdateCredentialUpdateCredentialResultResultCredential {
+	return v.Result
+}
+
+// GetErrors returns updateCredentialUpdateCredentialUpdateCredentialResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResult) GetErrors() []updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateCredentialUpdateCredentialUpdateCredentialResultResultCredential includes the requested fields of the GraphQL type Credential.
+type updateCredentialUpdateCredentialUpdateCredentialResultResultCredential struct {
+	Id        string `json:"id"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// GetId returns updateCredentialUpdateCredentialUpdateCredentialResultResultCredential.Id, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResultResultCredential) GetId() string {
+	return v.Id
+}
+
+// GetUpdatedAt returns updateCredentialUpdateCredentialUpdateCredentialResultResultCredential.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *updateCredentialUpdateCredentialUpdateCredentialResultResultCredential) GetUpdatedAt() string {
+	return v.UpdatedAt
+}
+
+// updateDatabaseAccessResponse is returned by updateDatabaseAccess on success.
+type updateDatabaseAccessResponse struct {
+	UpdateDatabaseAccess updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult `json:"updateDatabaseAccess"`
+}
+
+// GetUpdateDatabaseAccess returns updateDatabaseAccessResponse.UpdateDatabaseAccess, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessResponse) GetUpdateDatabaseAccess() updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult {
+	return v.UpdateDatabaseAccess
+}
+
+// updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult includes the requested fields of the GraphQL type UpdateDatabaseAccessResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_database_access mutation
+type updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult struct {
+	// The successful result of the mutation
+	Result updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult.Result, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult) GetResult() updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess {
+	return v.Result
+}
+
+// GetErrors returns updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult) GetErrors() []updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess includes the requested fields of the GraphQL type DatabaseAccess.
+type updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess struct {
+	Id string `json:"id"`
+}
+
+// GetId returns updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess.Id, and is useful for accessing the field via an interface.
+func (v *updateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess) GetId() string {
+	return v.Id
+}
+
+// updateDatabaseResponse is returned by updateDatabase on success.
+type updateDatabaseResponse struct {
+	UpdateDatabase updateDatabaseUpdateDatabaseUpdateDatabaseResult `json:"updateDatabase"`
+}
+
+// GetUpdateDatabase returns updateDatabaseResponse.UpdateDatabase, and is useful for accessing the field via an interface.
+func (v *updateDatabaseResponse) GetUpdateDatabase() updateDatabaseUpdateDatabaseUpdateDatabaseResult {
+	return v.UpdateDatabase
+}
+
+// updateDatabaseUpdateDatabaseUpdateDatabaseResult includes the requested fields of the GraphQL type UpdateDatabaseResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_database mutation
+type updateDatabaseUpdateDatabaseUpdateDatabaseResult struct {
+	// The successful result of the mutation
+	Result updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateDatabaseUpdateDatabaseUpdateDatabaseResult.Result, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResult) GetResult() updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase {
+	return v.Result
+}
+
+// GetErrors returns updateDatabaseUpdateDatabaseUpdateDatabaseResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResult) GetErrors() []updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase includes the requested fields of the GraphQL type Database.
+type updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase struct {
+	Id        string `json:"id"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// GetId returns updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase.Id, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase) GetId() string { return v.Id }
+
+// GetUpdatedAt returns updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase) GetUpdatedAt() string {
+	return v.UpdatedAt
+}
+
+// updateReviewPolicyResponse is returned by updateReviewPolicy on success.
+type updateReviewPolicyResponse struct {
+	UpdateReviewPolicy updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult `json:"updateReviewPolicy"`
+}
+
+// GetUpdateReviewPolicy returns updateReviewPolicyResponse.UpdateReviewPolicy, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyResponse) GetUpdateReviewPolicy() updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult {
+	return v.UpdateReviewPolicy
+}
+
+// updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult includes the requested fields of the GraphQL type UpdateReviewPolicyResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_review_policy mutation
+type updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult struct {
+	// The successful result of the mutation
+	Result updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult.Result, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult) GetResult() updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy {
+	return v.Result
+}
+
+// GetErrors returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult) GetErrors() []updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError) GetCode() string {
+	return v.Code
+}
+
+// GetMessage returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy includes the requested fields of the GraphQL type ReviewPolicy.
+type updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy struct {
+	Id string `json:"id"`
+	// The number of users who can review queries under this policy
+	ReviewerCount int `json:"reviewerCount"`
+}
+
+// GetId returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy.Id, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy) GetId() string {
+	return v.Id
+}
+
+// GetReviewerCount returns updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy.ReviewerCount, and is useful for accessing the field via an interface.
+func (v *updateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy) GetReviewerCount() int {
+	return v.ReviewerCount
+}
+
+// updateTeamResponse is returned by updateTeam on success.
+type updateTeamResponse struct {
+	UpdateTeam updateTeamUpdateTeamUpdateTeamResult `json:"updateTeam"`
+}
+
+// GetUpdateTeam returns updateTeamResponse.UpdateTeam, and is useful for accessing the field via an interface.
+func (v *updateTeamResponse) GetUpdateTeam() updateTeamUpdateTeamUpdateTeamResult {
+	return v.UpdateTeam
+}
+
+// updateTeamUpdateTeamUpdateTeamResult includes the requested fields of the GraphQL type UpdateTeamResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_team mutation
+type updateTeamUpdateTeamUpdateTeamResult struct {
+	// The successful result of the mutation
+	Result updateTeamUpdateTeamUpdateTeamResultResultTeam `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateTeamUpdateTeamUpdateTeamResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateTeamUpdateTeamUpdateTeamResult.Result, and is useful for accessing the field via an interface.
+func (v *updateTeamUpdateTeamUpdateTeamResult) GetResult() updateTeamUpdateTeamUpdateTeamResultResultTeam {
+	return v.Result
+}
+
+// GetErrors returns updateTeamUpdateTeamUpdateTeamResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateTeamUpdateTeamUpdateTeamResult) GetErrors() []updateTeamUpdateTeamUpdateTeamResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateTeamUpdateTeamUpdateTeamResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateTeamUpdateTeamUpdateTeamResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateTeamUpdateTeamUpdateTeamResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateTeamUpdateTeamUpdateTeamResultErrorsMutationError) GetCode() string { return v.Code }
+
+// GetMessage returns updateTeamUpdateTeamUpdateTeamResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateTeamUpdateTeamUpdateTeamResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateTeamUpdateTeamUpdateTeamResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateTeamUpdateTeamUpdateTeamResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateTeamUpdateTeamUpdateTeamResultResultTeam includes the requested fields of the GraphQL type Team.
+type updateTeamUpdateTeamUpdateTeamResultResultTeam struct {
+	Id string `json:"id"`
+}
+
+// GetId returns updateTeamUpdateTeamUpdateTeamResultResultTeam.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamUpdateTeamUpdateTeamResultResultTeam) GetId() string { return v.Id }
+
+// updateUserResponse is returned by updateUser on success.
+type updateUserResponse struct {
+	UpdateUser updateUserUpdateUserUpdateUserResult `json:"updateUser"`
+}
+
+// GetUpdateUser returns updateUserResponse.UpdateUser, and is useful for accessing the field via an interface.
+func (v *updateUserResponse) GetUpdateUser() updateUserUpdateUserUpdateUserResult {
+	return v.UpdateUser
+}
+
+// updateUserUpdateUserUpdateUserResult includes the requested fields of the GraphQL type UpdateUserResult.
+// The GraphQL type's documentation follows.
+//
+// The result of the :update_user mutation
+type updateUserUpdateUserUpdateUserResult struct {
+	// The successful result of the mutation
+	Result updateUserUpdateUserUpdateUserResultResultUser `json:"result"`
+	// Any errors generated, if the mutation failed
+	Errors []updateUserUpdateUserUpdateUserResultErrorsMutationError `json:"errors"`
+}
+
+// GetResult returns updateUserUpdateUserUpdateUserResult.Result, and is useful for accessing the field via an interface.
+func (v *updateUserUpdateUserUpdateUserResult) GetResult() updateUserUpdateUserUpdateUserResultResultUser {
+	return v.Result
+}
+
+// GetErrors returns updateUserUpdateUserUpdateUserResult.Errors, and is useful for accessing the field via an interface.
+func (v *updateUserUpdateUserUpdateUserResult) GetErrors() []updateUserUpdateUserUpdateUserResultErrorsMutationError {
+	return v.Errors
+}
+
+// updateUserUpdateUserUpdateUserResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
+// The GraphQL type's documentation follows.
+//
+// An error generated by a failed mutation
+type updateUserUpdateUserUpdateUserResultErrorsMutationError struct {
+	// An error code for the given error
+	Code string `json:"code"`
+	// The human readable error message
+	Message string `json:"message"`
+	// The field or fields that produced the error
+	Fields []string `json:"fields"`
+}
+
+// GetCode returns updateUserUpdateUserUpdateUserResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
+func (v *updateUserUpdateUserUpdateUserResultErrorsMutationError) GetCode() string { return v.Code }
+
+// GetMessage returns updateUserUpdateUserUpdateUserResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
+func (v *updateUserUpdateUserUpdateUserResultErrorsMutationError) GetMessage() string {
+	return v.Message
+}
+
+// GetFields returns updateUserUpdateUserUpdateUserResultErrorsMutationError.Fields, and is useful for accessing the field via an interface.
+func (v *updateUserUpdateUserUpdateUserResultErrorsMutationError) GetFields() []string {
+	return v.Fields
+}
+
+// updateUserUpdateUserUpdateUserResultResultUser includes the requested fields of the GraphQL type User.
+type updateUserUpdateUserUpdateUserResultResultUser struct {
+	Id string `json:"id"`
+}
+
+// GetId returns updateUserUpdateUserUpdateUserResultResultUser.Id, and is useful for accessing the field via an interface.
+func (v *updateUserUpdateUserUpdateUserResultResultUser) GetId() string { return v.Id }
+
+// The query or mutation executed by createApiKey.
+const createApiKey_Operation = `
+# @genqlient(for: "CreateApiKeyInput.readOnly", omitempty: true)
+# @genqlient(for: "CreateApiKeyInput.databaseIds", omitempty: true)
+# @genqlient(for: "CreateApiKeyInput.expiresAt", omitempty: true)
+mutation createApiKey ($input: CreateApiKeyInput!) {
+	createApiKey(input: $input) {
+		result {
+			id
+			secret
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func createApiKey(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateApiKeyInput,
+) (*createApiKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createApiKey",
+		Query:  createApiKey_Operation,
+		Variables: &__createApiKeyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createApiKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createCredential.
+const createCredential_Operation = `
+# @genqlient(for: "CreateCredentialInput.reviewPolicyId", omitempty: true)
+mutation createCredential ($input: CreateCredentialInput!) {
+	createCredential(input: $input) {
+		result {
+			id
+			updatedAt
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func createCredential(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateCredentialInput,
+) (*createCredentialResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCredential",
+		Query:  createCredential_Operation,
+		Variables: &__createCredentialInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCredentialResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDatabase.
+const createDatabase_Operation = `
+# Options left unset are omitted so that QueryDesk applies its defaults.
+# @genqlient(for: "CreateDatabaseInput.postgresOptions", pointer: true, omitempty: true)
+# @genqlient(for: "CreateDatabaseInput.mysqlOptions", pointer: true, omitempty: true)
+# @genqlient(for: "CreateDatabaseInput.sqlserverOptions", pointer: true, omitempty: true)
+# @genqlient(for: "DatabasePostgresOptionsInput.sslmode", omitempty: true)
+# @genqlient(for: "DatabasePostgresOptionsInput.searchPath", omitempty: true)
+# @genqlient(for: "DatabaseMysqlOptionsInput.charset", omitempty: true)
+# @genqlient(for: "DatabaseMysqlOptionsInput.collation", omitempty: true)
+# @genqlient(for: "DatabaseSqlserverOptionsInput.trustServerCertificate", pointer: true, omitempty: true)
+mutation createDatabase ($input: CreateDatabaseInput!) {
+	createDatabase(input: $input) {
+		result {
+			id
+			updatedAt
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+// Options left unset are omitted so that QueryDesk applies its defaults.
+func createDatabase(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateDatabaseInput,
+) (*createDatabaseResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatabase",
+		Query:  createDatabase_Operation,
+		Variables: &__createDatabaseInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createDatabaseResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDatabaseAccess.
+const createDatabaseAccess_Operation = `
+# Exactly one of userId and teamId is set.
+# @genqlient(for: "CreateDatabaseAccessInput.userId", omitempty: true)
+# @genqlient(for: "CreateDatabaseAccessInput.teamId", omitempty: true)
+# @genqlient(for: "CreateDatabaseAccessInput.credentialIds", omitempty: true)
+mutation createDatabaseAccess ($input: CreateDatabaseAccessInput!) {
+	createDatabaseAccess(input: $input) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+// Exactly one of userId and teamId is set.
+func createDatabaseAccess(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateDatabaseAccessInput,
+) (*createDatabaseAccessResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatabaseAccess",
+		Query:  createDatabaseAccess_Operation,
+		Variables: &__createDatabaseAccessInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createDatabaseAccessResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createReviewPolicy.
+const createReviewPolicy_Operation = `
+# @genqlient(for: "CreateReviewPolicyInput.reviewerTeamId", omitempty: true)
+mutation createReviewPolicy ($input: CreateReviewPolicyInput!) {
+	createReviewPolicy(input: $input) {
+		result {
+			id
+			reviewerCount
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func createReviewPolicy(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateReviewPolicyInput,
+) (*createReviewPolicyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createReviewPolicy",
+		Query:  createReviewPolicy_Operation,
+		Variables: &__createReviewPolicyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createReviewPolicyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createTeam.
+const createTeam_Operation = `
+mutation createTeam ($input: CreateTeamInput!) {
+	createTeam(input: $input) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func createTeam(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateTeamInput,
+) (*createTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTeam",
+		Query:  createTeam_Operation,
+		Variables: &__createTeamInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createTeamMembership.
+const createTeamMembership_Operation = `
+mutation createTeamMembership ($input: CreateTeamMembershipInput!) {
+	createTeamMembership(input: $input) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func createTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateTeamMembershipInput,
+) (*createTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTeamMembership",
+		Query:  createTeamMembership_Operation,
+		Variables: &__createTeamMembershipInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createUser.
+const createUser_Operation = `
+mutation createUser ($input: CreateUserInput!) {
+	createUser(input: $input) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func createUser(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateUserInput,
+) (*createUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "createUser",
+		Query:  createUser_Operation,
+		Variables: &__createUserInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteApiKey.
+const deleteApiKey_Operation = `
+mutation deleteApiKey ($id: ID!) {
+	deleteApiKey(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteApiKey(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteApiKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteApiKey",
+		Query:  deleteApiKey_Operation,
+		Variables: &__deleteApiKeyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteApiKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteCredential.
+const deleteCredential_Operation = `
+mutation deleteCredential ($id: ID!) {
+	deleteCredential(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteCredential(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCredentialResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCredential",
+		Query:  deleteCredential_Operation,
+		Variables: &__deleteCredentialInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCredentialResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteDatabase.
+const deleteDatabase_Operation = `
+mutation deleteDatabase ($id: ID!) {
+	deleteDatabase(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteDatabase(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteDatabaseResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteDatabase",
+		Query:  deleteDatabase_Operation,
+		Variables: &__deleteDatabaseInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteDatabaseResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteDatabaseAccess.
+const deleteDatabaseAccess_Operation = `
+mutation deleteDatabaseAccess ($id: ID!) {
+	deleteDatabaseAccess(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteDatabaseAccess(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteDatabaseAccessResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteDatabaseAccess",
+		Query:  deleteDatabaseAccess_Operation,
+		Variables: &__deleteDatabaseAccessInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteDatabaseAccessResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteReviewPolicy.
+const deleteReviewPolicy_Operation = `
+mutation deleteReviewPolicy ($id: ID!) {
+	deleteReviewPolicy(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteReviewPolicy(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteReviewPolicyResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteReviewPolicy",
+		Query:  deleteReviewPolicy_Operation,
+		Variables: &__deleteReviewPolicyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteReviewPolicyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteTeam.
+const deleteTeam_Operation = `
+mutation deleteTeam ($id: ID!) {
+	deleteTeam(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteTeam(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTeam",
+		Query:  deleteTeam_Operation,
+		Variables: &__deleteTeamInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteTeamMembership.
+const deleteTeamMembership_Operation = `
+mutation deleteTeamMembership ($id: ID!) {
+	deleteTeamMembership(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTeamMembership",
+		Query:  deleteTeamMembership_Operation,
+		Variables: &__deleteTeamMembershipInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteUser.
+const deleteUser_Operation = `
+mutation deleteUser ($id: ID!) {
+	deleteUser(id: $id) {
+		result {
+			id
+		}
+		errors {
+			code
+			message
+			fields
+		}
+	}
+}
+`
+
+func deleteUser(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteUser",
+		Query:  deleteUser_Operation,
+		Variables: &__deleteUserInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by findCredentialsByUsername.
+const findCredentialsByUsername_Operation = `
+# Users of a database with a username, to catch duplicates at plan time.
+query findCredentialsByUsername ($databaseId: ID!, $username: String!) {
+	database(id: $databaseId) {
+		id
+		credentials(filter: {username:{eq:$username}}) {
+			id
+		}
+	}
+}
+`
+
+// Users of a database with a username, to catch duplicates at plan time.
+func findCredentialsByUsername(
+	ctx context.Context,
+	client graphql.Client,
+	databaseId string,
+	username string,
+) (*findCredentialsByUsernameResponse, error) {
+	req := &graphql.Request{
+		OpName: "findCredentialsByUsername",
+		Query:  findCredentialsByUsername_Operation,
+		Variables: &__findCredentialsByUsernameInput{
+			DatabaseId: databaseId,
+			Username:   username,
+		},
+	}
+	var err error
+
+	var data findCredentialsByUsernameResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by findDatabasesByName.
+const findDatabasesByName_Operation = `
+# Databases with a name, to catch duplicates at plan time.
+query findDatabasesByName ($name: String!) {
+	listDatabases(filter: {name:{eq:$name}}) {
+		id
+	}
+}
+`
+
+// Databases with a name, to catch duplicates at plan time.
+func findDatabasesByName(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*findDatabasesByNameResponse, error) {
+	req := &graphql.Request{
+		OpName: "findDatabasesByName",
+		Query:  findDatabasesByName_Operation,
+		Variables: &__findDatabasesByNameInput{
+			Name: name,
+		},
+	}
+	var err error
+
+	var data findDatabasesByNameResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getApiKey.
+const getApiKey_Operation = `
+query getApiKey ($id: ID!) {
+	apiKey(id: $id) {
+		id
+		name
+		readOnly
+		databaseIds
+		expiresAt
+	}
+}
+`
+
+func getApiKey(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getApiKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "getApiKey",
+		Query:  getApiKey_Operation,
+		Variables: &__getApiKeyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getApiKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getCredential.
+const getCredential_Operation = `
+query getCredential ($id: ID!) {
+	credential(id: $id) {
+		id
+		description
+		username
+		reviewsRequired
+		reviewPolicyId
+		updatedAt
+		database {
+			id
+			adapter
+			hostname
+			database
+			ssl
+			postgresOptions {
+				sslmode
+				searchPath
+			}
+			mysqlOptions {
+				charset
+				collation
+			}
+			sqlserverOptions {
+				trustServerCertificate
+			}
+		}
+	}
+}
+`
+
+func getCredential(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCredentialResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCredential",
+		Query:  getCredential_Operation,
+		Variables: &__getCredentialInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCredentialResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getCurrentApiKey.
+const getCurrentApiKey_Operation = `
+query getCurrentApiKey {
+	currentApiKey {
+		id
+		name
+		readOnly
+		databaseIds
+		expiresAt
+	}
+}
+`
+
+func getCurrentApiKey(
+	ctx context.Context,
+	client graphql.Client,
+) (*getCurrentApiKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCurrentApiKey",
+		Query:  getCurrentApiKey_Operation,
+	}
+	var err error
+
+	var data getCurrentApiKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getDatabase.
+const getDatabase_Operation = `
+query getDatabase ($id: ID!) {
+	database(id: $id) {
+		id
+		name
+		adapter
+		hostname
+		database
+		ssl
+		restrictAccess
+		postgresOptions {
+			sslmode
+			searchPath
+		}
+		mysqlOptions {
+			charset
+			collation
+		}
+		sqlserverOptions {
+			trustServerCertificate
+		}
+		updatedAt
+	}
+}
+`
+
+func getDatabase(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDatabaseResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDatabase",
+		Query:  getDatabase_Operation,
+		Variables: &__getDatabaseInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDatabaseResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getDatabaseAccess.
+const getDatabaseAccess_Operation = `
+query getDatabaseAccess ($id: ID!) {
+	databaseAccess(id: $id) {
+		id
+		databaseId
+		userId
+		teamId
+		credentialIds
+	}
+}
+`
+
+func getDatabaseAccess(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDatabaseAccessResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDatabaseAccess",
+		Query:  getDatabaseAccess_Operation,
+		Variables: &__getDatabaseAccessInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDatabaseAccessResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getDatabaseCredentials.
+const getDatabaseCredentials_Operation = `
+# The users left on a database, deleted before it with force_delete.
+query getDatabaseCredentials ($id: ID!) {
+	database(id: $id) {
+		id
+		credentials {
+			id
+		}
+	}
+}
+`
+
+// The users left on a database, deleted before it with force_delete.
+func getDatabaseCredentials(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDatabaseCredentialsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDatabaseCredentials",
+		Query:  getDatabaseCredentials_Operation,
+		Variables: &__getDatabaseCredentialsInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDatabaseCredentialsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getReviewPolicy.
+const getReviewPolicy_Operation = `
+query getReviewPolicy ($id: ID!) {
+	reviewPolicy(id: $id) {
+		id
+		name
+		reviewerTeamId
+		allowSelfApproval
+		readOnlyBypass
+		reviewerCount
+	}
+}
+`
+
+func getReviewPolicy(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getReviewPolicyResponse, error) {
+	req := &graphql.Request{
+		OpName: "getReviewPolicy",
+		Query:  getReviewPolicy_Operation,
+		Variables: &__getReviewPolicyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getReviewPolicyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getTeam.
+const getTeam_Operation = `
+query getTeam ($id: ID!) {
+	team(id: $id) {
+		id
+		name
+		description
+	}
+}
+`
+
+func getTeam(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeam",
+		Query:  getTeam_Operation,
+		Variables: &__getTeamInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getTeamMembership.
+const getTeamMembership_Operation = `
+query getTeamMembership ($id: ID!) {
+	teamMembership(id: $id) {
+		id
+		teamId
+		userId
+	}
+}
+`
+
+func getTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamMembership",
+		Query:  getTeamMembership_Operation,
+		Variables: &__getTeamMembershipInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getUser.
+const getUser_Operation = `
+query getUser ($id: ID!) {
+	user(id: $id) {
+		id
+		email
+		name
+		role
+	}
+}
+`
+
+func getUser(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "getUser",
+		Query:  getUser_Operation,
+		Variables: &__getUserInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listAuditEvents.
+const listAuditEvents_Operation = `
+# Filters left unset are omitted, an empty filter matches every event. Events
+# are also sorted by id, as several can share a timestamp and offset
+# pagination needs a stable order.
+# @genqlient(omitempty: true)
+# @genqlient(for: "AuditEventFilterInput.databaseId", pointer: true)
+# @genqlient(for: "AuditEventFilterInput.credentialId", pointer: true)
+# @genqlient(for: "AuditEventFilterInput.userId", pointer: true)
+# @genqlient(for: "AuditEventFilterInput.insertedAt", pointer: true)
+# @genqlient(for: "AuditEventFilterInput.approvalStatus", pointer: true)
+query listAuditEvents ($filter: AuditEventFilterInput!, $limit: Int!, $offset: Int!) {
+	listAuditEvents(filter: $filter, sort: [{field:INSERTED_AT},{field:ID}], limit: $limit, offset: $offset) {
+		id
+		databaseId
+		credentialId
+		userId
+		query
+		approvalStatus
+		insertedAt
+	}
+}
+`
+
+// Filters left unset are omitted, an empty filter matches every event. Events
+// are also sorted by id, as several can share a timestamp and offset
+// pagination needs a stable order.
+func listAuditEvents(
+	ctx context.Context,
+	client graphql.Client,
+	filter AuditEventFilterInput,
+	limit int,
+	offset int,
+) (*listAuditEventsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listAuditEvents",
+		Query:  listAuditEvents_Operation,
+		Variables: &__listAuditEventsInput{
+			Filter: filter,
+			Limit:  limit,
+			Offset: offset,
+		},
+	}
+	var err error
+
+	var data listAuditEventsResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetMessage returns updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
-func (v *updateCredentialUpdateCredentialUpdateCredentialResultErrorsMutationError) GetMessage() string {
-	return v.Message
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// updateCredentialUpdateCredentialUpdateCredentialResultResultCredential includes the requested fields of the GraphQL type Credential.
-type updateCredentialUpdateCredentialUpdateCredentialResultResultCredential struct {
-	Id string `json:"id"`
+	return &data, err
 }
 
-// GetId returns updateCredentialUpdateCredentialUpdateCredentialResultResultCredential.Id, and is useful for accessing the field via an interface.
-func (v *updateCredentialUpdateCredentialUpdateCredentialResultResultCredential) GetId() string {
-	return v.Id
+// The query or mutation executed by listDatabases.
+const listDatabases_Operation = `
+query listDatabases ($limit: Int!, $offset: Int!) {
+	listDatabases(sort: [{field:NAME}], limit: $limit, offset: $offset) {
+		id
+		name
+		adapter
+		hostname
+		database
+		ssl
+		restrictAccess
+		postgresOptions {
+			sslmode
+			searchPath
+		}
+		mysqlOptions {
+			charset
+			collation
+		}
+		sqlserverOptions {
+			trustServerCertificate
+		}
+		credentials(sort: [{field:USERNAME}]) {
+			id
+			description
+			username
+			reviewsRequired
+			reviewPolicyId
+		}
+	}
 }
+`
 
-// updateDatabaseResponse is returned by updateDatabase on success.
-type updateDatabaseResponse struct {
-	UpdateDatabase updateDatabaseUpdateDatabaseUpdateDatabaseResult `json:"updateDatabase"`
-}
+func listDatabases(
+	ctx context.Context,
+	client graphql.Client,
+	limit int,
+	offset int,
+) (*listDatabasesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listDatabases",
+		Query:  listDatabases_Operation,
+		Variables: &__listDatabasesInput{
+			Limit:  limit,
+			Offset: offset,
+		},
+	}
+	var err error
 
-// GetUpdateDatabase returns updateDatabaseResponse.UpdateDatabase, and is useful for accessing the field via an interface.
-func (v *updateDatabaseResponse) GetUpdateDatabase() updateDatabaseUpdateDatabaseUpdateDatabaseResult {
-	return v.UpdateDatabase
-}
+	var data listDatabasesResponse
+	resp := &graphql.Response{Data: &data}
 
-// updateDatabaseUpdateDatabaseUpdateDatabaseResult includes the requested fields of the GraphQL type UpdateDatabaseResult.
-// The GraphQL type's documentation follows.
-//
-// The result of the :update_database mutation
-type updateDatabaseUpdateDatabaseUpdateDatabaseResult struct {
-	// The successful result of the mutation
-	Result updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase `json:"result"`
-	// Any errors generated, if the mutation failed
-	Errors []updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError `json:"errors"`
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetResult returns updateDatabaseUpdateDatabaseUpdateDatabaseResult.Result, and is useful for accessing the field via an interface.
-func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResult) GetResult() updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase {
-	return v.Result
+	return &data, err
 }
 
-// GetErrors returns updateDatabaseUpdateDatabaseUpdateDatabaseResult.Errors, and is useful for accessing the field via an interface.
-func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResult) GetErrors() []updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError {
-	return v.Errors
+// The query or mutation executed by ping.
+const ping_Operation = `
+query ping {
+	__typename
 }
+`
 
-// updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError includes the requested fields of the GraphQL type MutationError.
-// The GraphQL type's documentation follows.
-//
-// An error generated by a failed mutation
-type updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError struct {
-	// An error code for the given error
-	Code string `json:"code"`
-	// The human readable error message
-	Message string `json:"message"`
-}
+func ping(
+	ctx context.Context,
+	client graphql.Client,
+) (*pingResponse, error) {
+	req := &graphql.Request{
+		OpName: "ping",
+		Query:  ping_Operation,
+	}
+	var err error
 
-// GetCode returns updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError.Code, and is useful for accessing the field via an interface.
-func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError) GetCode() string {
-	return v.Code
-}
+	var data pingResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetMessage returns updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError.Message, and is useful for accessing the field via an interface.
-func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultErrorsMutationError) GetMessage() string {
-	return v.Message
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase includes the requested fields of the GraphQL type Database.
-type updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase struct {
-	Id string `json:"id"`
+	return &data, err
 }
 
-// GetId returns updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase.Id, and is useful for accessing the field via an interface.
-func (v *updateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase) GetId() string { return v.Id }
-
-// The query or mutation executed by createCredential.
-const createCredential_Operation = `
-mutation createCredential ($input: CreateCredentialInput!) {
-	createCredential(input: $input) {
+// The query or mutation executed by rotateApiKey.
+const rotateApiKey_Operation = `
+# Replaces the secret of the key, the previous secret stops working.
+mutation rotateApiKey ($id: ID!) {
+	rotateApiKey(id: $id) {
 		result {
 			id
+			secret
 		}
 		errors {
 			code
 			message
+			fields
 		}
 	}
 }
 `
 
-func createCredential(
+// Replaces the secret of the key, the previous secret stops working.
+func rotateApiKey(
 	ctx context.Context,
 	client graphql.Client,
-	input CreateCredentialInput,
-) (*createCredentialResponse, error) {
+	id string,
+) (*rotateApiKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "createCredential",
-		Query:  createCredential_Operation,
-		Variables: &__createCredentialInput{
-			Input: input,
+		OpName: "rotateApiKey",
+		Query:  rotateApiKey_Operation,
+		Variables: &__rotateApiKeyInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createCredentialResponse
+	var data rotateApiKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -702,36 +4708,39 @@ func createCredential(
 	return &data, err
 }
 
-// The query or mutation executed by createDatabase.
-const createDatabase_Operation = `
-mutation createDatabase ($input: CreateDatabaseInput!) {
-	createDatabase(input: $input) {
+// The query or mutation executed by updateApiKey.
+const updateApiKey_Operation = `
+mutation updateApiKey ($id: ID!, $input: UpdateApiKeyInput!) {
+	updateApiKey(id: $id, input: $input) {
 		result {
 			id
 		}
 		errors {
 			code
 			message
+			fields
 		}
 	}
 }
 `
 
-func createDatabase(
+func updateApiKey(
 	ctx context.Context,
 	client graphql.Client,
-	input CreateDatabaseInput,
-) (*createDatabaseResponse, error) {
+	id string,
+	input UpdateApiKeyInput,
+) (*updateApiKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatabase",
-		Query:  createDatabase_Operation,
-		Variables: &__createDatabaseInput{
+		OpName: "updateApiKey",
+		Query:  updateApiKey_Operation,
+		Variables: &__updateApiKeyInput{
+			Id:    id,
 			Input: input,
 		},
 	}
 	var err error
 
-	var data createDatabaseResponse
+	var data updateApiKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -743,36 +4752,51 @@ func createDatabase(
 	return &data, err
 }
 
-// The query or mutation executed by deleteCredential.
-const deleteCredential_Operation = `
-mutation deleteCredential ($id: ID!) {
-	deleteCredential(id: $id) {
+// The query or mutation executed by updateCredential.
+const updateCredential_Operation = `
+# Only changed fields are sent, so that concurrent edits to other fields in
+# the UI are kept. The review policy is sent as null to remove it, see
+# Nullable.
+# @genqlient(for: "UpdateCredentialInput.description", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateCredentialInput.username", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateCredentialInput.reviewsRequired", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateCredentialInput.newPassword", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateCredentialInput.reviewPolicyId", bind: "encoding/json.RawMessage", omitempty: true)
+mutation updateCredential ($id: ID!, $input: UpdateCredentialInput!) {
+	updateCredential(id: $id, input: $input) {
 		result {
 			id
+			updatedAt
 		}
 		errors {
 			code
 			message
+			fields
 		}
 	}
 }
 `
 
-func deleteCredential(
+// Only changed fields are sent, so that concurrent edits to other fields in
+// the UI are kept. The review policy is sent as null to remove it, see
+// Nullable.
+func updateCredential(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteCredentialResponse, error) {
+	input UpdateCredentialInput,
+) (*updateCredentialResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteCredential",
-		Query:  deleteCredential_Operation,
-		Variables: &__deleteCredentialInput{
-			Id: id,
+		OpName: "updateCredential",
+		Query:  updateCredential_Operation,
+		Variables: &__updateCredentialInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data deleteCredentialResponse
+	var data updateCredentialResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -784,36 +4808,59 @@ func deleteCredential(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatabase.
-const deleteDatabase_Operation = `
-mutation deleteDatabase ($id: ID!) {
-	deleteDatabase(id: $id) {
+// The query or mutation executed by updateDatabase.
+const updateDatabase_Operation = `
+# Only changed fields are sent, so that concurrent edits to other fields in
+# the UI are kept. Options are sent as null when their block is removed,
+# clearing them, see Nullable.
+# @genqlient(for: "UpdateDatabaseInput.name", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.adapter", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.hostname", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.database", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.ssl", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.restrictAccess", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.newCacertfile", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.newKeyfile", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.newCertfile", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.agentId", pointer: true, omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.postgresOptions", bind: "encoding/json.RawMessage", omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.mysqlOptions", bind: "encoding/json.RawMessage", omitempty: true)
+# @genqlient(for: "UpdateDatabaseInput.sqlserverOptions", bind: "encoding/json.RawMessage", omitempty: true)
+mutation updateDatabase ($id: ID!, $input: UpdateDatabaseInput!) {
+	updateDatabase(id: $id, input: $input) {
 		result {
 			id
+			updatedAt
 		}
 		errors {
 			code
 			message
+			fields
 		}
 	}
 }
 `
 
-func deleteDatabase(
+// Only changed fields are sent, so that concurrent edits to other fields in
+// the UI are kept. Options are sent as null when their block is removed,
+// clearing them, see Nullable.
+func updateDatabase(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDatabaseResponse, error) {
+	input UpdateDatabaseInput,
+) (*updateDatabaseResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatabase",
-		Query:  deleteDatabase_Operation,
-		Variables: &__deleteDatabaseInput{
-			Id: id,
+		OpName: "updateDatabase",
+		Query:  updateDatabase_Operation,
+		Variables: &__updateDatabaseInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data deleteDatabaseResponse
+	var data updateDatabaseResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -825,36 +4872,39 @@ func deleteDatabase(
 	return &data, err
 }
 
-// The query or mutation executed by getCredential.
-const getCredential_Operation = `
-query getCredential ($id: ID!) {
-	credential(id: $id) {
-		id
-		description
-		username
-		reviewsRequired
-		database {
+// The query or mutation executed by updateDatabaseAccess.
+const updateDatabaseAccess_Operation = `
+mutation updateDatabaseAccess ($id: ID!, $input: UpdateDatabaseAccessInput!) {
+	updateDatabaseAccess(id: $id, input: $input) {
+		result {
 			id
 		}
+		errors {
+			code
+			message
+			fields
+		}
 	}
 }
 `
 
-func getCredential(
+func updateDatabaseAccess(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getCredentialResponse, error) {
+	input UpdateDatabaseAccessInput,
+) (*updateDatabaseAccessResponse, error) {
 	req := &graphql.Request{
-		OpName: "getCredential",
-		Query:  getCredential_Operation,
-		Variables: &__getCredentialInput{
-			Id: id,
+		OpName: "updateDatabaseAccess",
+		Query:  updateDatabaseAccess_Operation,
+		Variables: &__updateDatabaseAccessInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getCredentialResponse
+	var data updateDatabaseAccessResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -866,36 +4916,43 @@ func getCredential(
 	return &data, err
 }
 
-// The query or mutation executed by getDatabase.
-const getDatabase_Operation = `
-query getDatabase ($id: ID!) {
-	database(id: $id) {
-		id
-		name
-		adapter
-		hostname
-		database
-		ssl
-		restrictAccess
+// The query or mutation executed by updateReviewPolicy.
+const updateReviewPolicy_Operation = `
+# The reviewer team is sent as null to let any user review.
+# @genqlient(for: "UpdateReviewPolicyInput.reviewerTeamId", pointer: true)
+mutation updateReviewPolicy ($id: ID!, $input: UpdateReviewPolicyInput!) {
+	updateReviewPolicy(id: $id, input: $input) {
+		result {
+			id
+			reviewerCount
+		}
+		errors {
+			code
+			message
+			fields
+		}
 	}
 }
 `
 
-func getDatabase(
+// The reviewer team is sent as null to let any user review.
+func updateReviewPolicy(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDatabaseResponse, error) {
+	input UpdateReviewPolicyInput,
+) (*updateReviewPolicyResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatabase",
-		Query:  getDatabase_Operation,
-		Variables: &__getDatabaseInput{
-			Id: id,
+		OpName: "updateReviewPolicy",
+		Query:  updateReviewPolicy_Operation,
+		Variables: &__updateReviewPolicyInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getDatabaseResponse
+	var data updateReviewPolicyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -907,38 +4964,39 @@ func getDatabase(
 	return &data, err
 }
 
-// The query or mutation executed by updateCredential.
-const updateCredential_Operation = `
-mutation updateCredential ($id: ID!, $input: UpdateCredentialInput!) {
-	updateCredential(id: $id, input: $input) {
+// The query or mutation executed by updateTeam.
+const updateTeam_Operation = `
+mutation updateTeam ($id: ID!, $input: UpdateTeamInput!) {
+	updateTeam(id: $id, input: $input) {
 		result {
 			id
 		}
 		errors {
 			code
 			message
+			fields
 		}
 	}
 }
 `
 
-func updateCredential(
+func updateTeam(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	input UpdateCredentialInput,
-) (*updateCredentialResponse, error) {
+	input UpdateTeamInput,
+) (*updateTeamResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateCredential",
-		Query:  updateCredential_Operation,
-		Variables: &__updateCredentialInput{
+		OpName: "updateTeam",
+		Query:  updateTeam_Operation,
+		Variables: &__updateTeamInput{
 			Id:    id,
 			Input: input,
 		},
 	}
 	var err error
 
-	var data updateCredentialResponse
+	var data updateTeamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -950,38 +5008,39 @@ func updateCredential(
 	return &data, err
 }
 
-// The query or mutation executed by updateDatabase.
-const updateDatabase_Operation = `
-mutation updateDatabase ($id: ID!, $input: UpdateDatabaseInput!) {
-	updateDatabase(id: $id, input: $input) {
+// The query or mutation executed by updateUser.
+const updateUser_Operation = `
+mutation updateUser ($id: ID!, $input: UpdateUserInput!) {
+	updateUser(id: $id, input: $input) {
 		result {
 			id
 		}
 		errors {
 			code
 			message
+			fields
 		}
 	}
 }
 `
 
-func updateDatabase(
+func updateUser(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	input UpdateDatabaseInput,
-) (*updateDatabaseResponse, error) {
+	input UpdateUserInput,
+) (*updateUserResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateDatabase",
-		Query:  updateDatabase_Operation,
-		Variables: &__updateDatabaseInput{
+		OpName: "updateUser",
+		Query:  updateUser_Operation,
+		Variables: &__updateUserInput{
 			Id:    id,
 			Input: input,
 		},
 	}
 	var err error
 
-	var data updateDatabaseResponse
+	var data updateUserResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -21,6 +21,61 @@ func (_m *MockGraphQLClient) EXPECT() *MockGraphQLClient_Expecter {
 	return &MockGraphQLClient_Expecter{mock: &_m.Mock}
 }
 
+// CreateApiKey provides a mock function with given fields: ctx, input
+func (_m *MockGraphQLClient) CreateApiKey(ctx context.Context, input CreateApiKeyInput) (*createApiKeyResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *createApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateApiKeyInput) (*createApiKeyResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateApiKeyInput) *createApiKeyResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*createApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateApiKeyInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_CreateApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateApiKey'
+type MockGraphQLClient_CreateApiKey_Call struct {
+	*mock.Call
+}
+
+// CreateApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateApiKeyInput
+func (_e *MockGraphQLClient_Expecter) CreateApiKey(ctx interface{}, input interface{}) *MockGraphQLClient_CreateApiKey_Call {
+	return &MockGraphQLClient_CreateApiKey_Call{Call: _e.mock.On("CreateApiKey", ctx, input)}
+}
+
+func (_c *MockGraphQLClient_CreateApiKey_Call) Run(run func(ctx context.Context, input CreateApiKeyInput)) *MockGraphQLClient_CreateApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateApiKeyInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_CreateApiKey_Call) Return(_a0 *createApiKeyResponse, _a1 error) *MockGraphQLClient_CreateApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_CreateApiKey_Call) RunAndReturn(run func(context.Context, CreateApiKeyInput) (*createApiKeyResponse, error)) *MockGraphQLClient_CreateApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: ctx, input
 func (_m *MockGraphQLClient) CreateCredential(ctx context.Context, input CreateCredentialInput) (*createCredentialResponse, error) {
 	ret := _m.Called(ctx, input)
@@ -131,25 +186,25 @@ func (_c *MockGraphQLClient_CreateDatabase_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// DeleteCredential provides a mock function with given fields: ctx, id
-func (_m *MockGraphQLClient) DeleteCredential(ctx context.Context, id string) (*deleteCredentialResponse, error) {
-	ret := _m.Called(ctx, id)
+// CreateDatabaseAccess provides a mock function with given fields: ctx, input
+func (_m *MockGraphQLClient) CreateDatabaseAccess(ctx context.Context, input CreateDatabaseAccessInput) (*createDatabaseAccessResponse, error) {
+	ret := _m.Called(ctx, input)
 
-	var r0 *deleteCredentialResponse
+	var r0 *createDatabaseAccessResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteCredentialResponse, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateDatabaseAccessInput) (*createDatabaseAccessResponse, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteCredentialResponse); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateDatabaseAccessInput) *createDatabaseAccessResponse); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*deleteCredentialResponse)
+			r0 = ret.Get(0).(*createDatabaseAccessResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, CreateDatabaseAccessInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -157,54 +212,54 @@ func (_m *MockGraphQLClient) DeleteCredential(ctx context.Context, id string) (*
 	return r0, r1
 }
 
-// MockGraphQLClient_DeleteCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCredential'
-type MockGraphQLClient_DeleteCredential_Call struct {
+// MockGraphQLClient_CreateDatabaseAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatabaseAccess'
+type MockGraphQLClient_CreateDatabaseAccess_Call struct {
 	*mock.Call
 }
 
-// DeleteCredential is a helper method to define mock.On call
+// CreateDatabaseAccess is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockGraphQLClient_Expecter) DeleteCredential(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteCredential_Call {
-	return &MockGraphQLClient_DeleteCredential_Call{Call: _e.mock.On("DeleteCredential", ctx, id)}
+//   - input CreateDatabaseAccessInput
+func (_e *MockGraphQLClient_Expecter) CreateDatabaseAccess(ctx interface{}, input interface{}) *MockGraphQLClient_CreateDatabaseAccess_Call {
+	return &MockGraphQLClient_CreateDatabaseAccess_Call{Call: _e.mock.On("CreateDatabaseAccess", ctx, input)}
 }
 
-func (_c *MockGraphQLClient_DeleteCredential_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteCredential_Call {
+func (_c *MockGraphQLClient_CreateDatabaseAccess_Call) Run(run func(ctx context.Context, input CreateDatabaseAccessInput)) *MockGraphQLClient_CreateDatabaseAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(CreateDatabaseAccessInput))
 	})
 	return _c
 }
 
-func (_c *MockGraphQLClient_DeleteCredential_Call) Return(_a0 *deleteCredentialResponse, _a1 error) *MockGraphQLClient_DeleteCredential_Call {
+func (_c *MockGraphQLClient_CreateDatabaseAccess_Call) Return(_a0 *createDatabaseAccessResponse, _a1 error) *MockGraphQLClient_CreateDatabaseAccess_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGraphQLClient_DeleteCredential_Call) RunAndReturn(run func(context.Context, string) (*deleteCredentialResponse, error)) *MockGraphQLClient_DeleteCredential_Call {
+func (_c *MockGraphQLClient_CreateDatabaseAccess_Call) RunAndReturn(run func(context.Context, CreateDatabaseAccessInput) (*createDatabaseAccessResponse, error)) *MockGraphQLClient_CreateDatabaseAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDatabase provides a mock function with given fields: ctx, id
-func (_m *MockGraphQLClient) DeleteDatabase(ctx context.Context, id string) (*deleteDatabaseResponse, error) {
-	ret := _m.Called(ctx, id)
+// CreateReviewPolicy provides a mock function with given fields: ctx, input
+func (_m *MockGraphQLClient) CreateReviewPolicy(ctx context.Context, input CreateReviewPolicyInput) (*createReviewPolicyResponse, error) {
+	ret := _m.Called(ctx, input)
 
-	var r0 *deleteDatabaseResponse
+	var r0 *createReviewPolicyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteDatabaseResponse, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateReviewPolicyInput) (*createReviewPolicyResponse, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteDatabaseResponse); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateReviewPolicyInput) *createReviewPolicyResponse); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*deleteDatabaseResponse)
+			r0 = ret.Get(0).(*createReviewPolicyResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, CreateReviewPolicyInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -212,54 +267,54 @@ func (_m *MockGraphQLClient) DeleteDatabase(ctx context.Context, id string) (*de
 	return r0, r1
 }
 
-// MockGraphQLClient_DeleteDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatabase'
-type MockGraphQLClient_DeleteDatabase_Call struct {
+// MockGraphQLClient_CreateReviewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReviewPolicy'
+type MockGraphQLClient_CreateReviewPolicy_Call struct {
 	*mock.Call
 }
 
-// DeleteDatabase is a helper method to define mock.On call
+// CreateReviewPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockGraphQLClient_Expecter) DeleteDatabase(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteDatabase_Call {
-	return &MockGraphQLClient_DeleteDatabase_Call{Call: _e.mock.On("DeleteDatabase", ctx, id)}
+//   - input CreateReviewPolicyInput
+func (_e *MockGraphQLClient_Expecter) CreateReviewPolicy(ctx interface{}, input interface{}) *MockGraphQLClient_CreateReviewPolicy_Call {
+	return &MockGraphQLClient_CreateReviewPolicy_Call{Call: _e.mock.On("CreateReviewPolicy", ctx, input)}
 }
 
-func (_c *MockGraphQLClient_DeleteDatabase_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteDatabase_Call {
+func (_c *MockGraphQLClient_CreateReviewPolicy_Call) Run(run func(ctx context.Context, input CreateReviewPolicyInput)) *MockGraphQLClient_CreateReviewPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(CreateReviewPolicyInput))
 	})
 	return _c
 }
 
-func (_c *MockGraphQLClient_DeleteDatabase_Call) Return(_a0 *deleteDatabaseResponse, _a1 error) *MockGraphQLClient_DeleteDatabase_Call {
+func (_c *MockGraphQLClient_CreateReviewPolicy_Call) Return(_a0 *createReviewPolicyResponse, _a1 error) *MockGraphQLClient_CreateReviewPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGraphQLClient_DeleteDatabase_Call) RunAndReturn(run func(context.Context, string) (*deleteDatabaseResponse, error)) *MockGraphQLClient_DeleteDatabase_Call {
+func (_c *MockGraphQLClient_CreateReviewPolicy_Call) RunAndReturn(run func(context.Context, CreateReviewPolicyInput) (*createReviewPolicyResponse, error)) *MockGraphQLClient_CreateReviewPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCredential provides a mock function with given fields: ctx, id
-func (_m *MockGraphQLClient) GetCredential(ctx context.Context, id string) (*getCredentialResponse, error) {
-	ret := _m.Called(ctx, id)
+// CreateTeam provides a mock function with given fields: ctx, input
+func (_m *MockGraphQLClient) CreateTeam(ctx context.Context, input CreateTeamInput) (*createTeamResponse, error) {
+	ret := _m.Called(ctx, input)
 
-	var r0 *getCredentialResponse
+	var r0 *createTeamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*getCredentialResponse, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateTeamInput) (*createTeamResponse, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *getCredentialResponse); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateTeamInput) *createTeamResponse); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*getCredentialResponse)
+			r0 = ret.Get(0).(*createTeamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, CreateTeamInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -267,54 +322,54 @@ func (_m *MockGraphQLClient) GetCredential(ctx context.Context, id string) (*get
 	return r0, r1
 }
 
-// MockGraphQLClient_GetCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredential'
-type MockGraphQLClient_GetCredential_Call struct {
+// MockGraphQLClient_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type MockGraphQLClient_CreateTeam_Call struct {
 	*mock.Call
 }
 
-// GetCredential is a helper method to define mock.On call
+// CreateTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockGraphQLClient_Expecter) GetCredential(ctx interface{}, id interface{}) *MockGraphQLClient_GetCredential_Call {
-	return &MockGraphQLClient_GetCredential_Call{Call: _e.mock.On("GetCredential", ctx, id)}
+//   - input CreateTeamInput
+func (_e *MockGraphQLClient_Expecter) CreateTeam(ctx interface{}, input interface{}) *MockGraphQLClient_CreateTeam_Call {
+	return &MockGraphQLClient_CreateTeam_Call{Call: _e.mock.On("CreateTeam", ctx, input)}
 }
 
-func (_c *MockGraphQLClient_GetCredential_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetCredential_Call {
+func (_c *MockGraphQLClient_CreateTeam_Call) Run(run func(ctx context.Context, input CreateTeamInput)) *MockGraphQLClient_CreateTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(CreateTeamInput))
 	})
 	return _c
 }
 
-func (_c *MockGraphQLClient_GetCredential_Call) Return(_a0 *getCredentialResponse, _a1 error) *MockGraphQLClient_GetCredential_Call {
+func (_c *MockGraphQLClient_CreateTeam_Call) Return(_a0 *createTeamResponse, _a1 error) *MockGraphQLClient_CreateTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGraphQLClient_GetCredential_Call) RunAndReturn(run func(context.Context, string) (*getCredentialResponse, error)) *MockGraphQLClient_GetCredential_Call {
+func (_c *MockGraphQLClient_CreateTeam_Call) RunAndReturn(run func(context.Context, CreateTeamInput) (*createTeamResponse, error)) *MockGraphQLClient_CreateTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatabase provides a mock function with given fields: ctx, id
-func (_m *MockGraphQLClient) GetDatabase(ctx context.Context, id string) (*getDatabaseResponse, error) {
-	ret := _m.Called(ctx, id)
+// CreateTeamMembership provides a mock function with given fields: ctx, input
+func (_m *MockGraphQLClient) CreateTeamMembership(ctx context.Context, input CreateTeamMembershipInput) (*createTeamMembershipResponse, error) {
+	ret := _m.Called(ctx, input)
 
-	var r0 *getDatabaseResponse
+	var r0 *createTeamMembershipResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*getDatabaseResponse, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateTeamMembershipInput) (*createTeamMembershipResponse, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *getDatabaseResponse); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateTeamMembershipInput) *createTeamMembershipResponse); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*getDatabaseResponse)
+			r0 = ret.Get(0).(*createTeamMembershipResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, CreateTeamMembershipInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -322,54 +377,54 @@ func (_m *MockGraphQLClient) GetDatabase(ctx context.Context, id string) (*getDa
 	return r0, r1
 }
 
-// MockGraphQLClient_GetDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatabase'
-type MockGraphQLClient_GetDatabase_Call struct {
+// MockGraphQLClient_CreateTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeamMembership'
+type MockGraphQLClient_CreateTeamMembership_Call struct {
 	*mock.Call
 }
 
-// GetDatabase is a helper method to define mock.On call
+// CreateTeamMembership is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockGraphQLClient_Expecter) GetDatabase(ctx interface{}, id interface{}) *MockGraphQLClient_GetDatabase_Call {
-	return &MockGraphQLClient_GetDatabase_Call{Call: _e.mock.On("GetDatabase", ctx, id)}
+//   - input CreateTeamMembershipInput
+func (_e *MockGraphQLClient_Expecter) CreateTeamMembership(ctx interface{}, input interface{}) *MockGraphQLClient_CreateTeamMembership_Call {
+	return &MockGraphQLClient_CreateTeamMembership_Call{Call: _e.mock.On("CreateTeamMembership", ctx, input)}
 }
 
-func (_c *MockGraphQLClient_GetDatabase_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetDatabase_Call {
+func (_c *MockGraphQLClient_CreateTeamMembership_Call) Run(run func(ctx context.Context, input CreateTeamMembershipInput)) *MockGraphQLClient_CreateTeamMembership_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(CreateTeamMembershipInput))
 	})
 	return _c
 }
 
-func (_c *MockGraphQLClient_GetDatabase_Call) Return(_a0 *getDatabaseResponse, _a1 error) *MockGraphQLClient_GetDatabase_Call {
+func (_c *MockGraphQLClient_CreateTeamMembership_Call) Return(_a0 *createTeamMembershipResponse, _a1 error) *MockGraphQLClient_CreateTeamMembership_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGraphQLClient_GetDatabase_Call) RunAndReturn(run func(context.Context, string) (*getDatabaseResponse, error)) *MockGraphQLClient_GetDatabase_Call {
+func (_c *MockGraphQLClient_CreateTeamMembership_Call) RunAndReturn(run func(context.Context, CreateTeamMembershipInput) (*createTeamMembershipResponse, error)) *MockGraphQLClient_CreateTeamMembership_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateCredential provides a mock function with given fields: ctx, id, input
-func (_m *MockGraphQLClient) UpdateCredential(ctx context.Context, id string, input UpdateCredentialInput) (*updateCredentialResponse, error) {
-	ret := _m.Called(ctx, id, input)
+// CreateUser provides a mock function with given fields: ctx, input
+func (_m *MockGraphQLClient) CreateUser(ctx context.Context, input CreateUserInput) (*createUserResponse, error) {
+	ret := _m.Called(ctx, input)
 
-	var r0 *updateCredentialResponse
+	var r0 *createUserResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateCredentialInput) (*updateCredentialResponse, error)); ok {
-		return rf(ctx, id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateUserInput) (*createUserResponse, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateCredentialInput) *updateCredentialResponse); ok {
-		r0 = rf(ctx, id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, CreateUserInput) *createUserResponse); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*updateCredentialResponse)
+			r0 = ret.Get(0).(*createUserResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateCredentialInput) error); ok {
-		r1 = rf(ctx, id, input)
+	if rf, ok := ret.Get(1).(func(context.Context, CreateUserInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,55 +432,109 @@ func (_m *MockGraphQLClient) UpdateCredential(ctx context.Context, id string, in
 	return r0, r1
 }
 
-// MockGraphQLClient_UpdateCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCredential'
-type MockGraphQLClient_UpdateCredential_Call struct {
+// MockGraphQLClient_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockGraphQLClient_CreateUser_Call struct {
 	*mock.Call
 }
 
-// UpdateCredential is a helper method to define mock.On call
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateUserInput
+func (_e *MockGraphQLClient_Expecter) CreateUser(ctx interface{}, input interface{}) *MockGraphQLClient_CreateUser_Call {
+	return &MockGraphQLClient_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, input)}
+}
+
+func (_c *MockGraphQLClient_CreateUser_Call) Run(run func(ctx context.Context, input CreateUserInput)) *MockGraphQLClient_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateUserInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_CreateUser_Call) Return(_a0 *createUserResponse, _a1 error) *MockGraphQLClient_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_CreateUser_Call) RunAndReturn(run func(context.Context, CreateUserInput) (*createUserResponse, error)) *MockGraphQLClient_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteApiKey provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteApiKey(ctx context.Context, id string) (*deleteApiKeyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteApiKeyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteApiKeyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApiKey'
+type MockGraphQLClient_DeleteApiKey_Call struct {
+	*mock.Call
+}
+
+// DeleteApiKey is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-//   - input UpdateCredentialInput
-func (_e *MockGraphQLClient_Expecter) UpdateCredential(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateCredential_Call {
-	return &MockGraphQLClient_UpdateCredential_Call{Call: _e.mock.On("UpdateCredential", ctx, id, input)}
+func (_e *MockGraphQLClient_Expecter) DeleteApiKey(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteApiKey_Call {
+	return &MockGraphQLClient_DeleteApiKey_Call{Call: _e.mock.On("DeleteApiKey", ctx, id)}
 }
 
-func (_c *MockGraphQLClient_UpdateCredential_Call) Run(run func(ctx context.Context, id string, input UpdateCredentialInput)) *MockGraphQLClient_UpdateCredential_Call {
+func (_c *MockGraphQLClient_DeleteApiKey_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteApiKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(UpdateCredentialInput))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockGraphQLClient_UpdateCredential_Call) Return(_a0 *updateCredentialResponse, _a1 error) *MockGraphQLClient_UpdateCredential_Call {
+func (_c *MockGraphQLClient_DeleteApiKey_Call) Return(_a0 *deleteApiKeyResponse, _a1 error) *MockGraphQLClient_DeleteApiKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGraphQLClient_UpdateCredential_Call) RunAndReturn(run func(context.Context, string, UpdateCredentialInput) (*updateCredentialResponse, error)) *MockGraphQLClient_UpdateCredential_Call {
+func (_c *MockGraphQLClient_DeleteApiKey_Call) RunAndReturn(run func(context.Context, string) (*deleteApiKeyResponse, error)) *MockGraphQLClient_DeleteApiKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatabase provides a mock function with given fields: ctx, id, input
-func (_m *MockGraphQLClient) UpdateDatabase(ctx context.Context, id string, input UpdateDatabaseInput) (*updateDatabaseResponse, error) {
-	ret := _m.Called(ctx, id, input)
+// DeleteCredential provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteCredential(ctx context.Context, id string) (*deleteCredentialResponse, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 *updateDatabaseResponse
+	var r0 *deleteCredentialResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateDatabaseInput) (*updateDatabaseResponse, error)); ok {
-		return rf(ctx, id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteCredentialResponse, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateDatabaseInput) *updateDatabaseResponse); ok {
-		r0 = rf(ctx, id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteCredentialResponse); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*updateDatabaseResponse)
+			r0 = ret.Get(0).(*deleteCredentialResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateDatabaseInput) error); ok {
-		r1 = rf(ctx, id, input)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -433,32 +542,1635 @@ func (_m *MockGraphQLClient) UpdateDatabase(ctx context.Context, id string, inpu
 	return r0, r1
 }
 
-// MockGraphQLClient_UpdateDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatabase'
-type MockGraphQLClient_UpdateDatabase_Call struct {
+// MockGraphQLClient_DeleteCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCredential'
+type MockGraphQLClient_DeleteCredential_Call struct {
 	*mock.Call
 }
 
-// UpdateDatabase is a helper method to define mock.On call
+// DeleteCredential is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-//   - input UpdateDatabaseInput
-func (_e *MockGraphQLClient_Expecter) UpdateDatabase(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateDatabase_Call {
-	return &MockGraphQLClient_UpdateDatabase_Call{Call: _e.mock.On("UpdateDatabase", ctx, id, input)}
+func (_e *MockGraphQLClient_Expecter) DeleteCredential(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteCredential_Call {
+	return &MockGraphQLClient_DeleteCredential_Call{Call: _e.mock.On("DeleteCredential", ctx, id)}
 }
 
-func (_c *MockGraphQLClient_UpdateDatabase_Call) Run(run func(ctx context.Context, id string, input UpdateDatabaseInput)) *MockGraphQLClient_UpdateDatabase_Call {
+func (_c *MockGraphQLClient_DeleteCredential_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(UpdateDatabaseInput))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockGraphQLClient_UpdateDatabase_Call) Return(_a0 *updateDatabaseResponse, _a1 error) *MockGraphQLClient_UpdateDatabase_Call {
+func (_c *MockGraphQLClient_DeleteCredential_Call) Return(_a0 *deleteCredentialResponse, _a1 error) *MockGraphQLClient_DeleteCredential_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGraphQLClient_UpdateDatabase_Call) RunAndReturn(run func(context.Context, string, UpdateDatabaseInput) (*updateDatabaseResponse, error)) *MockGraphQLClient_UpdateDatabase_Call {
+func (_c *MockGraphQLClient_DeleteCredential_Call) RunAndReturn(run func(context.Context, string) (*deleteCredentialResponse, error)) *MockGraphQLClient_DeleteCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatabase provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteDatabase(ctx context.Context, id string) (*deleteDatabaseResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteDatabaseResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteDatabaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatabase'
+type MockGraphQLClient_DeleteDatabase_Call struct {
+	*mock.Call
+}
+
+// DeleteDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) DeleteDatabase(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteDatabase_Call {
+	return &MockGraphQLClient_DeleteDatabase_Call{Call: _e.mock.On("DeleteDatabase", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_DeleteDatabase_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteDatabase_Call) Return(_a0 *deleteDatabaseResponse, _a1 error) *MockGraphQLClient_DeleteDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteDatabase_Call) RunAndReturn(run func(context.Context, string) (*deleteDatabaseResponse, error)) *MockGraphQLClient_DeleteDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatabaseAccess provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteDatabaseAccess(ctx context.Context, id string) (*deleteDatabaseAccessResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteDatabaseAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteDatabaseAccessResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteDatabaseAccessResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteDatabaseAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteDatabaseAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatabaseAccess'
+type MockGraphQLClient_DeleteDatabaseAccess_Call struct {
+	*mock.Call
+}
+
+// DeleteDatabaseAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) DeleteDatabaseAccess(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteDatabaseAccess_Call {
+	return &MockGraphQLClient_DeleteDatabaseAccess_Call{Call: _e.mock.On("DeleteDatabaseAccess", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_DeleteDatabaseAccess_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteDatabaseAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteDatabaseAccess_Call) Return(_a0 *deleteDatabaseAccessResponse, _a1 error) *MockGraphQLClient_DeleteDatabaseAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteDatabaseAccess_Call) RunAndReturn(run func(context.Context, string) (*deleteDatabaseAccessResponse, error)) *MockGraphQLClient_DeleteDatabaseAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReviewPolicy provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteReviewPolicy(ctx context.Context, id string) (*deleteReviewPolicyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteReviewPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteReviewPolicyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteReviewPolicyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteReviewPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteReviewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReviewPolicy'
+type MockGraphQLClient_DeleteReviewPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteReviewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) DeleteReviewPolicy(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteReviewPolicy_Call {
+	return &MockGraphQLClient_DeleteReviewPolicy_Call{Call: _e.mock.On("DeleteReviewPolicy", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_DeleteReviewPolicy_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteReviewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteReviewPolicy_Call) Return(_a0 *deleteReviewPolicyResponse, _a1 error) *MockGraphQLClient_DeleteReviewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteReviewPolicy_Call) RunAndReturn(run func(context.Context, string) (*deleteReviewPolicyResponse, error)) *MockGraphQLClient_DeleteReviewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteTeam(ctx context.Context, id string) (*deleteTeamResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteTeamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteTeamResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteTeamResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteTeamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type MockGraphQLClient_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) DeleteTeam(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteTeam_Call {
+	return &MockGraphQLClient_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_DeleteTeam_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteTeam_Call) Return(_a0 *deleteTeamResponse, _a1 error) *MockGraphQLClient_DeleteTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteTeam_Call) RunAndReturn(run func(context.Context, string) (*deleteTeamResponse, error)) *MockGraphQLClient_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeamMembership provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteTeamMembership(ctx context.Context, id string) (*deleteTeamMembershipResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteTeamMembershipResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteTeamMembershipResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteTeamMembershipResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteTeamMembershipResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeamMembership'
+type MockGraphQLClient_DeleteTeamMembership_Call struct {
+	*mock.Call
+}
+
+// DeleteTeamMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) DeleteTeamMembership(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteTeamMembership_Call {
+	return &MockGraphQLClient_DeleteTeamMembership_Call{Call: _e.mock.On("DeleteTeamMembership", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_DeleteTeamMembership_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteTeamMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteTeamMembership_Call) Return(_a0 *deleteTeamMembershipResponse, _a1 error) *MockGraphQLClient_DeleteTeamMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteTeamMembership_Call) RunAndReturn(run func(context.Context, string) (*deleteTeamMembershipResponse, error)) *MockGraphQLClient_DeleteTeamMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) DeleteUser(ctx context.Context, id string) (*deleteUserResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *deleteUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*deleteUserResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *deleteUserResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deleteUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockGraphQLClient_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockGraphQLClient_DeleteUser_Call {
+	return &MockGraphQLClient_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_DeleteUser_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteUser_Call) Return(_a0 *deleteUserResponse, _a1 error) *MockGraphQLClient_DeleteUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_DeleteUser_Call) RunAndReturn(run func(context.Context, string) (*deleteUserResponse, error)) *MockGraphQLClient_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCredentialsByUsername provides a mock function with given fields: ctx, databaseId, username
+func (_m *MockGraphQLClient) FindCredentialsByUsername(ctx context.Context, databaseId string, username string) (*findCredentialsByUsernameResponse, error) {
+	ret := _m.Called(ctx, databaseId, username)
+
+	var r0 *findCredentialsByUsernameResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*findCredentialsByUsernameResponse, error)); ok {
+		return rf(ctx, databaseId, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *findCredentialsByUsernameResponse); ok {
+		r0 = rf(ctx, databaseId, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*findCredentialsByUsernameResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, databaseId, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_FindCredentialsByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCredentialsByUsername'
+type MockGraphQLClient_FindCredentialsByUsername_Call struct {
+	*mock.Call
+}
+
+// FindCredentialsByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - databaseId string
+//   - username string
+func (_e *MockGraphQLClient_Expecter) FindCredentialsByUsername(ctx interface{}, databaseId interface{}, username interface{}) *MockGraphQLClient_FindCredentialsByUsername_Call {
+	return &MockGraphQLClient_FindCredentialsByUsername_Call{Call: _e.mock.On("FindCredentialsByUsername", ctx, databaseId, username)}
+}
+
+func (_c *MockGraphQLClient_FindCredentialsByUsername_Call) Run(run func(ctx context.Context, databaseId string, username string)) *MockGraphQLClient_FindCredentialsByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_FindCredentialsByUsername_Call) Return(_a0 *findCredentialsByUsernameResponse, _a1 error) *MockGraphQLClient_FindCredentialsByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_FindCredentialsByUsername_Call) RunAndReturn(run func(context.Context, string, string) (*findCredentialsByUsernameResponse, error)) *MockGraphQLClient_FindCredentialsByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDatabasesByName provides a mock function with given fields: ctx, name
+func (_m *MockGraphQLClient) FindDatabasesByName(ctx context.Context, name string) (*findDatabasesByNameResponse, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *findDatabasesByNameResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*findDatabasesByNameResponse, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *findDatabasesByNameResponse); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*findDatabasesByNameResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_FindDatabasesByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDatabasesByName'
+type MockGraphQLClient_FindDatabasesByName_Call struct {
+	*mock.Call
+}
+
+// FindDatabasesByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockGraphQLClient_Expecter) FindDatabasesByName(ctx interface{}, name interface{}) *MockGraphQLClient_FindDatabasesByName_Call {
+	return &MockGraphQLClient_FindDatabasesByName_Call{Call: _e.mock.On("FindDatabasesByName", ctx, name)}
+}
+
+func (_c *MockGraphQLClient_FindDatabasesByName_Call) Run(run func(ctx context.Context, name string)) *MockGraphQLClient_FindDatabasesByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_FindDatabasesByName_Call) Return(_a0 *findDatabasesByNameResponse, _a1 error) *MockGraphQLClient_FindDatabasesByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_FindDatabasesByName_Call) RunAndReturn(run func(context.Context, string) (*findDatabasesByNameResponse, error)) *MockGraphQLClient_FindDatabasesByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApiKey provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetApiKey(ctx context.Context, id string) (*getApiKeyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getApiKeyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getApiKeyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApiKey'
+type MockGraphQLClient_GetApiKey_Call struct {
+	*mock.Call
+}
+
+// GetApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetApiKey(ctx interface{}, id interface{}) *MockGraphQLClient_GetApiKey_Call {
+	return &MockGraphQLClient_GetApiKey_Call{Call: _e.mock.On("GetApiKey", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetApiKey_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetApiKey_Call) Return(_a0 *getApiKeyResponse, _a1 error) *MockGraphQLClient_GetApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetApiKey_Call) RunAndReturn(run func(context.Context, string) (*getApiKeyResponse, error)) *MockGraphQLClient_GetApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCredential provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetCredential(ctx context.Context, id string) (*getCredentialResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getCredentialResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getCredentialResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getCredentialResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getCredentialResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredential'
+type MockGraphQLClient_GetCredential_Call struct {
+	*mock.Call
+}
+
+// GetCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetCredential(ctx interface{}, id interface{}) *MockGraphQLClient_GetCredential_Call {
+	return &MockGraphQLClient_GetCredential_Call{Call: _e.mock.On("GetCredential", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetCredential_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetCredential_Call) Return(_a0 *getCredentialResponse, _a1 error) *MockGraphQLClient_GetCredential_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetCredential_Call) RunAndReturn(run func(context.Context, string) (*getCredentialResponse, error)) *MockGraphQLClient_GetCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrentApiKey provides a mock function with given fields: ctx
+func (_m *MockGraphQLClient) GetCurrentApiKey(ctx context.Context) (*getCurrentApiKeyResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *getCurrentApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*getCurrentApiKeyResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *getCurrentApiKeyResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getCurrentApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetCurrentApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentApiKey'
+type MockGraphQLClient_GetCurrentApiKey_Call struct {
+	*mock.Call
+}
+
+// GetCurrentApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGraphQLClient_Expecter) GetCurrentApiKey(ctx interface{}) *MockGraphQLClient_GetCurrentApiKey_Call {
+	return &MockGraphQLClient_GetCurrentApiKey_Call{Call: _e.mock.On("GetCurrentApiKey", ctx)}
+}
+
+func (_c *MockGraphQLClient_GetCurrentApiKey_Call) Run(run func(ctx context.Context)) *MockGraphQLClient_GetCurrentApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetCurrentApiKey_Call) Return(_a0 *getCurrentApiKeyResponse, _a1 error) *MockGraphQLClient_GetCurrentApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetCurrentApiKey_Call) RunAndReturn(run func(context.Context) (*getCurrentApiKeyResponse, error)) *MockGraphQLClient_GetCurrentApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatabase provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetDatabase(ctx context.Context, id string) (*getDatabaseResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getDatabaseResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getDatabaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatabase'
+type MockGraphQLClient_GetDatabase_Call struct {
+	*mock.Call
+}
+
+// GetDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetDatabase(ctx interface{}, id interface{}) *MockGraphQLClient_GetDatabase_Call {
+	return &MockGraphQLClient_GetDatabase_Call{Call: _e.mock.On("GetDatabase", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetDatabase_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetDatabase_Call) Return(_a0 *getDatabaseResponse, _a1 error) *MockGraphQLClient_GetDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetDatabase_Call) RunAndReturn(run func(context.Context, string) (*getDatabaseResponse, error)) *MockGraphQLClient_GetDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatabaseAccess provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetDatabaseAccess(ctx context.Context, id string) (*getDatabaseAccessResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getDatabaseAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getDatabaseAccessResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getDatabaseAccessResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getDatabaseAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetDatabaseAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatabaseAccess'
+type MockGraphQLClient_GetDatabaseAccess_Call struct {
+	*mock.Call
+}
+
+// GetDatabaseAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetDatabaseAccess(ctx interface{}, id interface{}) *MockGraphQLClient_GetDatabaseAccess_Call {
+	return &MockGraphQLClient_GetDatabaseAccess_Call{Call: _e.mock.On("GetDatabaseAccess", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetDatabaseAccess_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetDatabaseAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetDatabaseAccess_Call) Return(_a0 *getDatabaseAccessResponse, _a1 error) *MockGraphQLClient_GetDatabaseAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetDatabaseAccess_Call) RunAndReturn(run func(context.Context, string) (*getDatabaseAccessResponse, error)) *MockGraphQLClient_GetDatabaseAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatabaseCredentials provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetDatabaseCredentials(ctx context.Context, id string) (*getDatabaseCredentialsResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getDatabaseCredentialsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getDatabaseCredentialsResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getDatabaseCredentialsResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getDatabaseCredentialsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetDatabaseCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatabaseCredentials'
+type MockGraphQLClient_GetDatabaseCredentials_Call struct {
+	*mock.Call
+}
+
+// GetDatabaseCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetDatabaseCredentials(ctx interface{}, id interface{}) *MockGraphQLClient_GetDatabaseCredentials_Call {
+	return &MockGraphQLClient_GetDatabaseCredentials_Call{Call: _e.mock.On("GetDatabaseCredentials", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetDatabaseCredentials_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetDatabaseCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetDatabaseCredentials_Call) Return(_a0 *getDatabaseCredentialsResponse, _a1 error) *MockGraphQLClient_GetDatabaseCredentials_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetDatabaseCredentials_Call) RunAndReturn(run func(context.Context, string) (*getDatabaseCredentialsResponse, error)) *MockGraphQLClient_GetDatabaseCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReviewPolicy provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetReviewPolicy(ctx context.Context, id string) (*getReviewPolicyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getReviewPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getReviewPolicyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getReviewPolicyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getReviewPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetReviewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReviewPolicy'
+type MockGraphQLClient_GetReviewPolicy_Call struct {
+	*mock.Call
+}
+
+// GetReviewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetReviewPolicy(ctx interface{}, id interface{}) *MockGraphQLClient_GetReviewPolicy_Call {
+	return &MockGraphQLClient_GetReviewPolicy_Call{Call: _e.mock.On("GetReviewPolicy", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetReviewPolicy_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetReviewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetReviewPolicy_Call) Return(_a0 *getReviewPolicyResponse, _a1 error) *MockGraphQLClient_GetReviewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetReviewPolicy_Call) RunAndReturn(run func(context.Context, string) (*getReviewPolicyResponse, error)) *MockGraphQLClient_GetReviewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeam provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetTeam(ctx context.Context, id string) (*getTeamResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getTeamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getTeamResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getTeamResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getTeamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeam'
+type MockGraphQLClient_GetTeam_Call struct {
+	*mock.Call
+}
+
+// GetTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetTeam(ctx interface{}, id interface{}) *MockGraphQLClient_GetTeam_Call {
+	return &MockGraphQLClient_GetTeam_Call{Call: _e.mock.On("GetTeam", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetTeam_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetTeam_Call) Return(_a0 *getTeamResponse, _a1 error) *MockGraphQLClient_GetTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetTeam_Call) RunAndReturn(run func(context.Context, string) (*getTeamResponse, error)) *MockGraphQLClient_GetTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamMembership provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetTeamMembership(ctx context.Context, id string) (*getTeamMembershipResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getTeamMembershipResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getTeamMembershipResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getTeamMembershipResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getTeamMembershipResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembership'
+type MockGraphQLClient_GetTeamMembership_Call struct {
+	*mock.Call
+}
+
+// GetTeamMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetTeamMembership(ctx interface{}, id interface{}) *MockGraphQLClient_GetTeamMembership_Call {
+	return &MockGraphQLClient_GetTeamMembership_Call{Call: _e.mock.On("GetTeamMembership", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetTeamMembership_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetTeamMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetTeamMembership_Call) Return(_a0 *getTeamMembershipResponse, _a1 error) *MockGraphQLClient_GetTeamMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetTeamMembership_Call) RunAndReturn(run func(context.Context, string) (*getTeamMembershipResponse, error)) *MockGraphQLClient_GetTeamMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) GetUser(ctx context.Context, id string) (*getUserResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *getUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*getUserResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *getUserResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*getUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockGraphQLClient_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) GetUser(ctx interface{}, id interface{}) *MockGraphQLClient_GetUser_Call {
+	return &MockGraphQLClient_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_GetUser_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetUser_Call) Return(_a0 *getUserResponse, _a1 error) *MockGraphQLClient_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_GetUser_Call) RunAndReturn(run func(context.Context, string) (*getUserResponse, error)) *MockGraphQLClient_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, filter, limit, offset
+func (_m *MockGraphQLClient) ListAuditEvents(ctx context.Context, filter AuditEventFilterInput, limit int, offset int) (*listAuditEventsResponse, error) {
+	ret := _m.Called(ctx, filter, limit, offset)
+
+	var r0 *listAuditEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AuditEventFilterInput, int, int) (*listAuditEventsResponse, error)); ok {
+		return rf(ctx, filter, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AuditEventFilterInput, int, int) *listAuditEventsResponse); ok {
+		r0 = rf(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*listAuditEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AuditEventFilterInput, int, int) error); ok {
+		r1 = rf(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type MockGraphQLClient_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter AuditEventFilterInput
+//   - limit int
+//   - offset int
+func (_e *MockGraphQLClient_Expecter) ListAuditEvents(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *MockGraphQLClient_ListAuditEvents_Call {
+	return &MockGraphQLClient_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", ctx, filter, limit, offset)}
+}
+
+func (_c *MockGraphQLClient_ListAuditEvents_Call) Run(run func(ctx context.Context, filter AuditEventFilterInput, limit int, offset int)) *MockGraphQLClient_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(AuditEventFilterInput), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_ListAuditEvents_Call) Return(_a0 *listAuditEventsResponse, _a1 error) *MockGraphQLClient_ListAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_ListAuditEvents_Call) RunAndReturn(run func(context.Context, AuditEventFilterInput, int, int) (*listAuditEventsResponse, error)) *MockGraphQLClient_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDatabases provides a mock function with given fields: ctx, limit, offset
+func (_m *MockGraphQLClient) ListDatabases(ctx context.Context, limit int, offset int) (*listDatabasesResponse, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	var r0 *listDatabasesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*listDatabasesResponse, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *listDatabasesResponse); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*listDatabasesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_ListDatabases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabases'
+type MockGraphQLClient_ListDatabases_Call struct {
+	*mock.Call
+}
+
+// ListDatabases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *MockGraphQLClient_Expecter) ListDatabases(ctx interface{}, limit interface{}, offset interface{}) *MockGraphQLClient_ListDatabases_Call {
+	return &MockGraphQLClient_ListDatabases_Call{Call: _e.mock.On("ListDatabases", ctx, limit, offset)}
+}
+
+func (_c *MockGraphQLClient_ListDatabases_Call) Run(run func(ctx context.Context, limit int, offset int)) *MockGraphQLClient_ListDatabases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_ListDatabases_Call) Return(_a0 *listDatabasesResponse, _a1 error) *MockGraphQLClient_ListDatabases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_ListDatabases_Call) RunAndReturn(run func(context.Context, int, int) (*listDatabasesResponse, error)) *MockGraphQLClient_ListDatabases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ping provides a mock function with given fields: ctx
+func (_m *MockGraphQLClient) Ping(ctx context.Context) (*pingResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *pingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*pingResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *pingResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockGraphQLClient_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGraphQLClient_Expecter) Ping(ctx interface{}) *MockGraphQLClient_Ping_Call {
+	return &MockGraphQLClient_Ping_Call{Call: _e.mock.On("Ping", ctx)}
+}
+
+func (_c *MockGraphQLClient_Ping_Call) Run(run func(ctx context.Context)) *MockGraphQLClient_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_Ping_Call) Return(_a0 *pingResponse, _a1 error) *MockGraphQLClient_Ping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_Ping_Call) RunAndReturn(run func(context.Context) (*pingResponse, error)) *MockGraphQLClient_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateApiKey provides a mock function with given fields: ctx, id
+func (_m *MockGraphQLClient) RotateApiKey(ctx context.Context, id string) (*rotateApiKeyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *rotateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*rotateApiKeyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *rotateApiKeyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rotateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_RotateApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateApiKey'
+type MockGraphQLClient_RotateApiKey_Call struct {
+	*mock.Call
+}
+
+// RotateApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockGraphQLClient_Expecter) RotateApiKey(ctx interface{}, id interface{}) *MockGraphQLClient_RotateApiKey_Call {
+	return &MockGraphQLClient_RotateApiKey_Call{Call: _e.mock.On("RotateApiKey", ctx, id)}
+}
+
+func (_c *MockGraphQLClient_RotateApiKey_Call) Run(run func(ctx context.Context, id string)) *MockGraphQLClient_RotateApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_RotateApiKey_Call) Return(_a0 *rotateApiKeyResponse, _a1 error) *MockGraphQLClient_RotateApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_RotateApiKey_Call) RunAndReturn(run func(context.Context, string) (*rotateApiKeyResponse, error)) *MockGraphQLClient_RotateApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateApiKey provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateApiKey(ctx context.Context, id string, input UpdateApiKeyInput) (*updateApiKeyResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateApiKeyInput) (*updateApiKeyResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateApiKeyInput) *updateApiKeyResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateApiKeyInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApiKey'
+type MockGraphQLClient_UpdateApiKey_Call struct {
+	*mock.Call
+}
+
+// UpdateApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateApiKeyInput
+func (_e *MockGraphQLClient_Expecter) UpdateApiKey(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateApiKey_Call {
+	return &MockGraphQLClient_UpdateApiKey_Call{Call: _e.mock.On("UpdateApiKey", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateApiKey_Call) Run(run func(ctx context.Context, id string, input UpdateApiKeyInput)) *MockGraphQLClient_UpdateApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateApiKeyInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateApiKey_Call) Return(_a0 *updateApiKeyResponse, _a1 error) *MockGraphQLClient_UpdateApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateApiKey_Call) RunAndReturn(run func(context.Context, string, UpdateApiKeyInput) (*updateApiKeyResponse, error)) *MockGraphQLClient_UpdateApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCredential provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateCredential(ctx context.Context, id string, input UpdateCredentialInput) (*updateCredentialResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateCredentialResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateCredentialInput) (*updateCredentialResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateCredentialInput) *updateCredentialResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateCredentialResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateCredentialInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCredential'
+type MockGraphQLClient_UpdateCredential_Call struct {
+	*mock.Call
+}
+
+// UpdateCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateCredentialInput
+func (_e *MockGraphQLClient_Expecter) UpdateCredential(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateCredential_Call {
+	return &MockGraphQLClient_UpdateCredential_Call{Call: _e.mock.On("UpdateCredential", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateCredential_Call) Run(run func(ctx context.Context, id string, input UpdateCredentialInput)) *MockGraphQLClient_UpdateCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateCredentialInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateCredential_Call) Return(_a0 *updateCredentialResponse, _a1 error) *MockGraphQLClient_UpdateCredential_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateCredential_Call) RunAndReturn(run func(context.Context, string, UpdateCredentialInput) (*updateCredentialResponse, error)) *MockGraphQLClient_UpdateCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatabase provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateDatabase(ctx context.Context, id string, input UpdateDatabaseInput) (*updateDatabaseResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateDatabaseInput) (*updateDatabaseResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateDatabaseInput) *updateDatabaseResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateDatabaseInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatabase'
+type MockGraphQLClient_UpdateDatabase_Call struct {
+	*mock.Call
+}
+
+// UpdateDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateDatabaseInput
+func (_e *MockGraphQLClient_Expecter) UpdateDatabase(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateDatabase_Call {
+	return &MockGraphQLClient_UpdateDatabase_Call{Call: _e.mock.On("UpdateDatabase", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateDatabase_Call) Run(run func(ctx context.Context, id string, input UpdateDatabaseInput)) *MockGraphQLClient_UpdateDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateDatabaseInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateDatabase_Call) Return(_a0 *updateDatabaseResponse, _a1 error) *MockGraphQLClient_UpdateDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateDatabase_Call) RunAndReturn(run func(context.Context, string, UpdateDatabaseInput) (*updateDatabaseResponse, error)) *MockGraphQLClient_UpdateDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatabaseAccess provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateDatabaseAccess(ctx context.Context, id string, input UpdateDatabaseAccessInput) (*updateDatabaseAccessResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateDatabaseAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateDatabaseAccessInput) (*updateDatabaseAccessResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateDatabaseAccessInput) *updateDatabaseAccessResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateDatabaseAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateDatabaseAccessInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateDatabaseAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatabaseAccess'
+type MockGraphQLClient_UpdateDatabaseAccess_Call struct {
+	*mock.Call
+}
+
+// UpdateDatabaseAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateDatabaseAccessInput
+func (_e *MockGraphQLClient_Expecter) UpdateDatabaseAccess(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateDatabaseAccess_Call {
+	return &MockGraphQLClient_UpdateDatabaseAccess_Call{Call: _e.mock.On("UpdateDatabaseAccess", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateDatabaseAccess_Call) Run(run func(ctx context.Context, id string, input UpdateDatabaseAccessInput)) *MockGraphQLClient_UpdateDatabaseAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateDatabaseAccessInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateDatabaseAccess_Call) Return(_a0 *updateDatabaseAccessResponse, _a1 error) *MockGraphQLClient_UpdateDatabaseAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateDatabaseAccess_Call) RunAndReturn(run func(context.Context, string, UpdateDatabaseAccessInput) (*updateDatabaseAccessResponse, error)) *MockGraphQLClient_UpdateDatabaseAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReviewPolicy provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateReviewPolicy(ctx context.Context, id string, input UpdateReviewPolicyInput) (*updateReviewPolicyResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateReviewPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateReviewPolicyInput) (*updateReviewPolicyResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateReviewPolicyInput) *updateReviewPolicyResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateReviewPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateReviewPolicyInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateReviewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReviewPolicy'
+type MockGraphQLClient_UpdateReviewPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateReviewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateReviewPolicyInput
+func (_e *MockGraphQLClient_Expecter) UpdateReviewPolicy(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateReviewPolicy_Call {
+	return &MockGraphQLClient_UpdateReviewPolicy_Call{Call: _e.mock.On("UpdateReviewPolicy", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateReviewPolicy_Call) Run(run func(ctx context.Context, id string, input UpdateReviewPolicyInput)) *MockGraphQLClient_UpdateReviewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateReviewPolicyInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateReviewPolicy_Call) Return(_a0 *updateReviewPolicyResponse, _a1 error) *MockGraphQLClient_UpdateReviewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateReviewPolicy_Call) RunAndReturn(run func(context.Context, string, UpdateReviewPolicyInput) (*updateReviewPolicyResponse, error)) *MockGraphQLClient_UpdateReviewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeam provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateTeam(ctx context.Context, id string, input UpdateTeamInput) (*updateTeamResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateTeamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateTeamInput) (*updateTeamResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateTeamInput) *updateTeamResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateTeamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateTeamInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type MockGraphQLClient_UpdateTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateTeamInput
+func (_e *MockGraphQLClient_Expecter) UpdateTeam(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateTeam_Call {
+	return &MockGraphQLClient_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateTeam_Call) Run(run func(ctx context.Context, id string, input UpdateTeamInput)) *MockGraphQLClient_UpdateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateTeamInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateTeam_Call) Return(_a0 *updateTeamResponse, _a1 error) *MockGraphQLClient_UpdateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateTeam_Call) RunAndReturn(run func(context.Context, string, UpdateTeamInput) (*updateTeamResponse, error)) *MockGraphQLClient_UpdateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, id, input
+func (_m *MockGraphQLClient) UpdateUser(ctx context.Context, id string, input UpdateUserInput) (*updateUserResponse, error) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *updateUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateUserInput) (*updateUserResponse, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, UpdateUserInput) *updateUserResponse); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*updateUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, UpdateUserInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGraphQLClient_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockGraphQLClient_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input UpdateUserInput
+func (_e *MockGraphQLClient_Expecter) UpdateUser(ctx interface{}, id interface{}, input interface{}) *MockGraphQLClient_UpdateUser_Call {
+	return &MockGraphQLClient_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, input)}
+}
+
+func (_c *MockGraphQLClient_UpdateUser_Call) Run(run func(ctx context.Context, id string, input UpdateUserInput)) *MockGraphQLClient_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(UpdateUserInput))
+	})
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateUser_Call) Return(_a0 *updateUserResponse, _a1 error) *MockGraphQLClient_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGraphQLClient_UpdateUser_Call) RunAndReturn(run func(context.Context, string, UpdateUserInput) (*updateUserResponse, error)) *MockGraphQLClient_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
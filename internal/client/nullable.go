@@ -0,0 +1,17 @@
+package client
+
+import "encoding/json"
+
+// Nullable encodes v for a nullable update input field that is bound to
+// json.RawMessage, as null when v is nil to clear the field. Fields left as a
+// nil json.RawMessage are not sent at all, leaving them unchanged.
+func Nullable[T any](v *T) json.RawMessage {
+	if v == nil {
+		return json.RawMessage("null")
+	}
+
+	// Input types only hold strings, numbers and booleans, which always encode.
+	encoded, _ := json.Marshal(v)
+
+	return encoded
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullable_UpdateInput(t *testing.T) {
+	username := "app"
+	policyId := "policy_12345"
+
+	tests := map[string]struct {
+		input UpdateCredentialInput
+		want  string
+	}{
+		"unchanged": {
+			input: UpdateCredentialInput{},
+			want:  `{}`,
+		},
+		"changed": {
+			input: UpdateCredentialInput{Username: &username, ReviewPolicyId: Nullable(&policyId)},
+			want:  `{"username":"app","reviewPolicyId":"policy_12345"}`,
+		},
+		"cleared": {
+			input: UpdateCredentialInput{ReviewPolicyId: Nullable[string](nil)},
+			want:  `{"reviewPolicyId":null}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			encoded, err := json.Marshal(test.input)
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.want, string(encoded))
+		})
+	}
+
+	encoded, err := json.Marshal(UpdateDatabaseInput{PostgresOptions: Nullable(&DatabasePostgresOptionsInput{SearchPath: "app"})})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"postgresOptions":{"searchPath":"app"}}`, string(encoded))
+}
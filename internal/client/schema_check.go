@@ -0,0 +1,245 @@
+package client
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// bundledSchema is the schema the client was generated from.
+//
+//go:embed schema.graphql
+var bundledSchema string
+
+// The introspection query only asks for what CheckSchema compares. gqlfetch
+// is not used at runtime because it exits the process on network errors and
+// ignores the TLS and proxy settings in ClientOptions.
+const introspectionQuery = `
+query schemaCheck {
+  __schema {
+    types {
+      kind
+      name
+      fields(includeDeprecated: true) {
+        name
+        args { name defaultValue type { ...TypeRef } }
+      }
+      inputFields { name defaultValue type { ...TypeRef } }
+      enumValues(includeDeprecated: true) { name }
+    }
+  }
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } }
+}`
+
+type introspectionResponse struct {
+	Schema struct {
+		Types []introspectionType `json:"types"`
+	} `json:"__schema"`
+}
+
+type introspectionType struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Fields []struct {
+		Name string                    `json:"name"`
+		Args []introspectionInputValue `json:"args"`
+	} `json:"fields"`
+	InputFields []introspectionInputValue `json:"inputFields"`
+	EnumValues  []struct {
+		Name string `json:"name"`
+	} `json:"enumValues"`
+}
+
+type introspectionInputValue struct {
+	Name         string            `json:"name"`
+	DefaultValue *string           `json:"defaultValue"`
+	Type         *introspectionRef `json:"type"`
+}
+
+type introspectionRef struct {
+	Kind   string            `json:"kind"`
+	Name   string            `json:"name"`
+	OfType *introspectionRef `json:"ofType"`
+}
+
+// CheckSchema introspects the live API and describes every difference from
+// the bundled schema that could break the provider: removed types, fields and
+// enum values, added enum values and newly required inputs. An empty result
+// means the API is compatible.
+func CheckSchema(ctx context.Context, c graphql.Client) ([]string, error) {
+	bundled, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: bundledSchema})
+	if err != nil {
+		return nil, fmt.Errorf("parsing bundled schema: %w", err)
+	}
+
+	var data introspectionResponse
+	err = c.MakeRequest(ctx, &graphql.Request{OpName: "schemaCheck", Query: introspectionQuery}, &graphql.Response{Data: &data})
+	if err != nil {
+		return nil, fmt.Errorf("introspecting schema: %w", err)
+	}
+
+	return compareSchemas(bundled, liveSchema(data)), nil
+}
+
+// liveSchema converts an introspection response into the same form as the
+// parsed bundled schema.
+func liveSchema(data introspectionResponse) *ast.Schema {
+	schema := &ast.Schema{Types: map[string]*ast.Definition{}}
+
+	for _, t := range data.Schema.Types {
+		def := &ast.Definition{Kind: ast.DefinitionKind(t.Kind), Name: t.Name}
+
+		for _, f := range t.Fields {
+			field := &ast.FieldDefinition{Name: f.Name}
+			for _, a := range f.Args {
+				field.Arguments = append(field.Arguments, inputValue(a))
+			}
+			def.Fields = append(def.Fields, field)
+		}
+
+		for _, f := range t.InputFields {
+			arg := inputValue(f)
+			def.Fields = append(def.Fields, &ast.FieldDefinition{Name: arg.Name, Type: arg.Type, DefaultValue: arg.DefaultValue})
+		}
+
+		for _, v := range t.EnumValues {
+			def.EnumValues = append(def.EnumValues, &ast.EnumValueDefinition{Name: v.Name})
+		}
+
+		schema.Types[t.Name] = def
+	}
+
+	return schema
+}
+
+func inputValue(v introspectionInputValue) *ast.ArgumentDefinition {
+	arg := &ast.ArgumentDefinition{Name: v.Name, Type: astType(v.Type)}
+	if v.DefaultValue != nil {
+		arg.DefaultValue = &ast.Value{Raw: *v.DefaultValue}
+	}
+
+	return arg
+}
+
+func astType(ref *introspectionRef) *ast.Type {
+	switch {
+	case ref == nil:
+		return nil
+	case ref.Kind == "NON_NULL":
+		t := astType(ref.OfType)
+		if t != nil {
+			t.NonNull = true
+		}
+		return t
+	case ref.Kind == "LIST":
+		return ast.ListType(astType(ref.OfType), nil)
+	default:
+		return ast.NamedType(ref.Name, nil)
+	}
+}
+
+func compareSchemas(bundled *ast.Schema, live *ast.Schema) []string {
+	var problems []string
+
+	names := make([]string, 0, len(bundled.Types))
+	for name, def := range bundled.Types {
+		if !def.BuiltIn {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	returned := map[string]bool{}
+	for _, name := range names {
+		if kind := bundled.Types[name].Kind; kind == ast.Object || kind == ast.Interface {
+			for _, field := range bundled.Types[name].Fields {
+				returned[field.Type.Name()] = true
+			}
+		}
+	}
+
+	for _, name := range names {
+		want := bundled.Types[name]
+
+		got, ok := live.Types[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("type %s was removed", name))
+			continue
+		}
+
+		switch want.Kind {
+		case ast.Object, ast.Interface:
+			for _, field := range want.Fields {
+				liveField := got.Fields.ForName(field.Name)
+				if liveField == nil {
+					problems = append(problems, fmt.Sprintf("field %s.%s was removed", name, field.Name))
+					continue
+				}
+
+				for _, arg := range liveField.Arguments {
+					if !required(arg.Type, arg.DefaultValue) {
+						continue
+					}
+
+					bundledArg := field.Arguments.ForName(arg.Name)
+					switch {
+					case bundledArg == nil:
+						problems = append(problems, fmt.Sprintf("field %s.%s has a new required argument %s", name, field.Name, arg.Name))
+					case !required(bundledArg.Type, bundledArg.DefaultValue):
+						problems = append(problems, fmt.Sprintf("argument %s.%s(%s) is now required", name, field.Name, arg.Name))
+					}
+				}
+			}
+		case ast.InputObject:
+			for _, field := range want.Fields {
+				liveField := got.Fields.ForName(field.Name)
+				switch {
+				case liveField == nil:
+					problems = append(problems, fmt.Sprintf("input field %s.%s was removed", name, field.Name))
+				case required(liveField.Type, liveField.DefaultValue) && !required(field.Type, field.DefaultValue):
+					problems = append(problems, fmt.Sprintf("input field %s.%s is now required", name, field.Name))
+				}
+			}
+
+			for _, field := range got.Fields {
+				if required(field.Type, field.DefaultValue) && want.Fields.ForName(field.Name) == nil {
+					problems = append(problems, fmt.Sprintf("input %s has a new required field %s", name, field.Name))
+				}
+			}
+		case ast.Enum:
+			for _, value := range want.EnumValues {
+				if got.EnumValues.ForName(value.Name) == nil {
+					problems = append(problems, fmt.Sprintf("enum value %s.%s was removed", name, value.Name))
+				}
+			}
+
+			// A new value only breaks the provider if the API can return it,
+			// e.g. a new DatabaseAdapter, not if it is only accepted as input.
+			if !returned[name] {
+				continue
+			}
+
+			for _, value := range got.EnumValues {
+				if want.EnumValues.ForName(value.Name) == nil {
+					problems = append(problems, fmt.Sprintf("enum %s has a new value %s", name, value.Name))
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+func required(t *ast.Type, defaultValue *ast.Value) bool {
+	return t != nil && t.NonNull && defaultValue == nil
+}
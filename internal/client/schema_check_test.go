@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func loadSchema(t *testing.T, sdl string) *ast.Schema {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "test.graphql", Input: sdl})
+	require.NoError(t, err)
+
+	return schema
+}
+
+func TestCompareSchemas_Bundled(t *testing.T) {
+	bundled := loadSchema(t, bundledSchema)
+
+	assert.Empty(t, compareSchemas(bundled, bundled))
+}
+
+func TestCompareSchemas(t *testing.T) {
+	bundled := loadSchema(t, `
+enum DatabaseAdapter { POSTGRES MYSQL }
+enum SortOrder { ASC DESC }
+input CreateDatabaseInput { name: String! hostname: String agentId: String }
+type Database { id: ID! name: String! adapter: DatabaseAdapter! }
+type Removed { id: ID! }
+type Query {
+  database(id: ID!): Database
+  databases(sort: SortOrder, limit: Int): [Database!]!
+}
+`)
+
+	live := loadSchema(t, `
+enum DatabaseAdapter { POSTGRES SQLSERVER }
+enum SortOrder { ASC DESC NEWEST }
+input CreateDatabaseInput { name: String! hostname: String! port: Int! ssl: Boolean = false }
+type Database { id: ID! adapter: DatabaseAdapter! }
+type Query {
+  database(id: ID!): Database
+  databases(sort: SortOrder, limit: Int!, org: ID!): [Database!]!
+}
+`)
+
+	assert.Equal(t, []string{
+		"input field CreateDatabaseInput.hostname is now required",
+		"input field CreateDatabaseInput.agentId was removed",
+		"input CreateDatabaseInput has a new required field port",
+		"field Database.name was removed",
+		"enum value DatabaseAdapter.MYSQL was removed",
+		"enum DatabaseAdapter has a new value SQLSERVER",
+		"argument Query.databases(limit) is now required",
+		"field Query.databases has a new required argument org",
+		"type Removed was removed",
+	}, compareSchemas(bundled, live))
+}
+
+func TestCheckSchema(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"__schema":{"types":[
+			{"kind":"ENUM","name":"DatabaseAdapter","fields":null,"inputFields":null,
+			 "enumValues":[{"name":"POSTGRES"},{"name":"MYSQL"},{"name":"SQLSERVER"},{"name":"ORACLE"}]},
+			{"kind":"INPUT_OBJECT","name":"CreateCredentialInput","fields":null,"enumValues":null,"inputFields":[
+				{"name":"description","defaultValue":null,"type":{"kind":"SCALAR","name":"String","ofType":null}},
+				{"name":"username","defaultValue":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}}},
+				{"name":"reviewsRequired","defaultValue":"0","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"Int","ofType":null}}},
+				{"name":"password","defaultValue":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}}},
+				{"name":"databaseId","defaultValue":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}}},
+				{"name":"expiresAt","defaultValue":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}}}
+			]}
+		]}}}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{})
+	require.NoError(t, err)
+
+	problems, err := CheckSchema(context.Background(), *c)
+	require.NoError(t, err)
+
+	assert.Contains(t, problems, "enum DatabaseAdapter has a new value ORACLE")
+	assert.Contains(t, problems, "input CreateCredentialInput has a new required field expiresAt")
+	assert.Contains(t, problems, "type Database was removed")
+	assert.NotContains(t, problems, "enum value DatabaseAdapter.POSTGRES was removed")
+}
+
+func TestCheckSchema_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	host := server.URL
+	apiKey := "test"
+	c, err := NewClient(&host, &apiKey, ClientOptions{})
+	require.NoError(t, err)
+
+	_, err = CheckSchema(context.Background(), *c)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
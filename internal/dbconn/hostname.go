@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dbconn converts database connection settings between the form
+// QueryDesk stores them in and the form used in Terraform configuration,
+// shared by the provider and the export command.
+package dbconn
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// SplitHostname splits the port embedded in a hostname as stored by QueryDesk
+// from the host, keeping IPv6 addresses bracketed. ok is false if hostname has
+// no port, in which case it is returned unchanged.
+func SplitHostname(hostname string) (host string, port int64, ok bool) {
+	host, portString, err := net.SplitHostPort(hostname)
+	if err != nil {
+		return hostname, 0, false
+	}
+
+	port, err = strconv.ParseInt(portString, 10, 64)
+	if err != nil {
+		return hostname, 0, false
+	}
+
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	return host, port, true
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dbconn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitHostname(t *testing.T) {
+	tests := []struct {
+		hostname string
+		wantHost string
+		wantPort int64
+		wantOk   bool
+	}{
+		{"db.internal", "db.internal", 0, false},
+		{"db.internal:5433", "db.internal", 5433, true},
+		{"db.internal:0", "db.internal", 0, true},
+		{"10.0.0.1:3307", "10.0.0.1", 3307, true},
+		{"[::1]", "[::1]", 0, false},
+		{"[::1]:5433", "[::1]", 5433, true},
+		{"db.internal:port", "db.internal:port", 0, false},
+	}
+
+	for _, test := range tests {
+		host, port, ok := SplitHostname(test.hostname)
+		assert.Equal(t, test.wantHost, host, test.hostname)
+		assert.Equal(t, test.wantPort, port, test.hostname)
+		assert.Equal(t, test.wantOk, ok, test.hostname)
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dbconn
+
+import (
+	"strings"
+	"terraform-provider-querydesk/internal/client"
+)
+
+// PostgresSslmodeEnum converts a libpq sslmode such as verify-full into the
+// QueryDesk enum value VERIFY_FULL.
+func PostgresSslmodeEnum(sslmode string) client.DatabasePostgresSslmode {
+	return client.DatabasePostgresSslmode(strings.ToUpper(strings.ReplaceAll(sslmode, "-", "_")))
+}
+
+// PostgresSslmodeValue converts a QueryDesk enum value such as VERIFY_FULL
+// into the libpq sslmode verify-full.
+func PostgresSslmodeValue(sslmode client.DatabasePostgresSslmode) string {
+	return strings.ToLower(strings.ReplaceAll(string(sslmode), "_", "-"))
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dbconn
+
+import (
+	"testing"
+
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresSslmode(t *testing.T) {
+	assert.Equal(t, client.DatabasePostgresSslmodeVerifyFull, PostgresSslmodeEnum("verify-full"))
+	assert.Equal(t, client.DatabasePostgresSslmodeDisable, PostgresSslmodeEnum("disable"))
+	assert.Equal(t, "verify-ca", PostgresSslmodeValue(client.DatabasePostgresSslmodeVerifyCa))
+	assert.Equal(t, "", PostgresSslmodeValue(""))
+}
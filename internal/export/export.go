@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package export generates Terraform configuration and import blocks for the
+// databases and credentials that already exist in a QueryDesk account.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"terraform-provider-querydesk/internal/client"
+	"terraform-provider-querydesk/internal/dbconn"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// pageSize is how many databases are requested at a time.
+const pageSize = 100
+
+const (
+	resourcesFile = "querydesk_resources.tf"
+	importsFile   = "querydesk_imports.tf"
+	variablesFile = "querydesk_variables.tf"
+)
+
+// Export lists every database and credential visible to c and writes their
+// configuration into dir. Existing files are never overwritten.
+func Export(ctx context.Context, c client.GraphQLClient, dir string) error {
+	databases, err := listDatabases(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	files := Render(databases)
+
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return fmt.Errorf("%s already exists, remove it or export into another directory", filepath.Join(dir, name))
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), contents, 0600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listDatabases(ctx context.Context, c client.GraphQLClient) ([]client.ListDatabasesListDatabasesDatabase, error) {
+	var databases []client.ListDatabasesListDatabasesDatabase
+
+	for offset := 0; ; offset += pageSize {
+		resp, err := c.ListDatabases(ctx, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("listing databases: %w", err)
+		}
+
+		databases = append(databases, resp.ListDatabases...)
+
+		if len(resp.ListDatabases) < pageSize {
+			return databases, nil
+		}
+	}
+}
+
+// Render returns the contents of each generated file by name. Credentials
+// reference their database resource, and since the API never returns
+// passwords each credential gets a sensitive variable for its password.
+func Render(databases []client.ListDatabasesListDatabasesDatabase) map[string][]byte {
+	resources := hclwrite.NewEmptyFile()
+	imports := hclwrite.NewEmptyFile()
+	variables := hclwrite.NewEmptyFile()
+
+	labels := map[string]bool{}
+
+	for _, database := range databases {
+		databaseLabel := uniqueLabel(labels, database.Name)
+
+		body := appendBlock(resources, "resource", "querydesk_database", databaseLabel)
+		body.SetAttributeValue("name", cty.StringVal(database.Name))
+		body.SetAttributeValue("adapter", cty.StringVal(string(database.Adapter)))
+
+		hostname, port, ok := dbconn.SplitHostname(database.Hostname)
+		body.SetAttributeValue("hostname", cty.StringVal(hostname))
+		if ok {
+			body.SetAttributeValue("port", cty.NumberIntVal(port))
+		}
+
+		body.SetAttributeValue("database", cty.StringVal(database.Database))
+		if database.Ssl {
+			body.SetAttributeValue("ssl", cty.True)
+		}
+		if !database.RestrictAccess {
+			body.SetAttributeValue("restrict_access", cty.False)
+		}
+
+		if options := database.PostgresOptions; options.Sslmode != "" || options.SearchPath != "" {
+			block := body.AppendNewBlock("postgres", nil).Body()
+			if options.Sslmode != "" {
+				// The provider uses the libpq spelling, e.g. verify-full for VERIFY_FULL.
+				block.SetAttributeValue("sslmode", cty.StringVal(dbconn.PostgresSslmodeValue(options.Sslmode)))
+			}
+			if options.SearchPath != "" {
+				block.SetAttributeValue("search_path", cty.StringVal(options.SearchPath))
+			}
+		}
+
+		if options := database.MysqlOptions; options.Charset != "" || options.Collation != "" {
+			block := body.AppendNewBlock("mysql", nil).Body()
+			if options.Charset != "" {
+				block.SetAttributeValue("charset", cty.StringVal(options.Charset))
+			}
+			if options.Collation != "" {
+				block.SetAttributeValue("collation", cty.StringVal(options.Collation))
+			}
+		}
+
+		if database.SqlserverOptions.TrustServerCertificate {
+			block := body.AppendNewBlock("sqlserver", nil).Body()
+			block.SetAttributeValue("trust_server_certificate", cty.True)
+		}
+
+		appendImport(imports, "querydesk_database", databaseLabel, database.Id)
+
+		for _, credential := range database.Credentials {
+			credentialLabel := uniqueLabel(labels, database.Name+"_"+credential.Username)
+			passwordVariable := credentialLabel + "_password"
+
+			body := appendBlock(resources, "resource", "querydesk_database_user", credentialLabel)
+			body.SetAttributeTraversal("database_id", hcl.Traversal{
+				hcl.TraverseRoot{Name: "querydesk_database"},
+				hcl.TraverseAttr{Name: databaseLabel},
+				hcl.TraverseAttr{Name: "id"},
+			})
+			body.SetAttributeValue("username", cty.StringVal(credential.Username))
+			body.SetAttributeTraversal("password", hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: passwordVariable},
+			})
+			body.SetAttributeValue("reviews_required", cty.NumberIntVal(int64(credential.ReviewsRequired)))
+			if credential.ReviewPolicyId != "" {
+				body.SetAttributeValue("review_policy_id", cty.StringVal(credential.ReviewPolicyId))
+			}
+			if credential.Description != "" {
+				body.SetAttributeValue("description", cty.StringVal(credential.Description))
+			}
+
+			appendImport(imports, "querydesk_database_user", credentialLabel, credential.Id)
+
+			variable := appendBlock(variables, "variable", passwordVariable)
+			variable.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("Password of the %s user on the %s database.", credential.Username, database.Name)))
+			variable.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+			variable.SetAttributeValue("sensitive", cty.True)
+		}
+	}
+
+	return map[string][]byte{
+		resourcesFile: hclwrite.Format(resources.Bytes()),
+		importsFile:   hclwrite.Format(imports.Bytes()),
+		variablesFile: hclwrite.Format(variables.Bytes()),
+	}
+}
+
+// appendBlock appends a block to f, separated from any previous block by an
+// empty line, and returns its body.
+func appendBlock(f *hclwrite.File, typeName string, labels ...string) *hclwrite.Body {
+	if len(f.Body().Blocks()) > 0 {
+		f.Body().AppendNewline()
+	}
+
+	return f.Body().AppendNewBlock(typeName, labels).Body()
+}
+
+func appendImport(f *hclwrite.File, resourceType string, label string, id string) {
+	body := appendBlock(f, "import")
+	body.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	body.SetAttributeValue("id", cty.StringVal(id))
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueLabel turns name into a valid resource name, adding a numeric suffix
+// if it has already been used.
+func uniqueLabel(used map[string]bool, name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = "database"
+	} else if label[0] >= '0' && label[0] <= '9' {
+		label = "db_" + label
+	}
+
+	candidate := label
+	for i := 2; used[candidate]; i++ {
+		candidate = label + "_" + strconv.Itoa(i)
+	}
+	used[candidate] = true
+
+	return candidate
+}
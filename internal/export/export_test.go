@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	files := Render([]client.ListDatabasesListDatabasesDatabase{
+		{
+			Id:             "db_1",
+			Name:           "Billing DB",
+			Adapter:        client.DatabaseAdapterPostgres,
+			Hostname:       "db.internal:5433",
+			Database:       "billing",
+			Ssl:            true,
+			RestrictAccess: true,
+			PostgresOptions: client.ListDatabasesListDatabasesDatabasePostgresOptions{
+				Sslmode:    client.DatabasePostgresSslmodeVerifyFull,
+				SearchPath: "billing, public",
+			},
+			Credentials: []client.ListDatabasesListDatabasesDatabaseCredentialsCredential{
+				{Id: "cred_1", Username: "readonly", ReviewsRequired: 1, ReviewPolicyId: "policy_1", Description: "Read only"},
+			},
+		},
+		{
+			Id:             "db_2",
+			Name:           "billing-db",
+			Adapter:        client.DatabaseAdapterMysql,
+			Hostname:       "mysql.internal",
+			Database:       "billing",
+			RestrictAccess: false,
+			MysqlOptions: client.ListDatabasesListDatabasesDatabaseMysqlOptions{
+				Charset: "utf8mb4",
+			},
+		},
+		{
+			Id:             "db_3",
+			Name:           "Reporting",
+			Adapter:        client.DatabaseAdapterSqlserver,
+			Hostname:       "mssql.internal",
+			Database:       "reporting",
+			Ssl:            true,
+			RestrictAccess: true,
+			SqlserverOptions: client.ListDatabasesListDatabasesDatabaseSqlserverOptions{
+				TrustServerCertificate: true,
+			},
+		},
+	})
+
+	assert.Equal(t, `resource "querydesk_database" "billing_db" {
+  name     = "Billing DB"
+  adapter  = "POSTGRES"
+  hostname = "db.internal"
+  port     = 5433
+  database = "billing"
+  ssl      = true
+  postgres {
+    sslmode     = "verify-full"
+    search_path = "billing, public"
+  }
+}
+
+resource "querydesk_database_user" "billing_db_readonly" {
+  database_id      = querydesk_database.billing_db.id
+  username         = "readonly"
+  password         = var.billing_db_readonly_password
+  reviews_required = 1
+  review_policy_id = "policy_1"
+  description      = "Read only"
+}
+
+resource "querydesk_database" "billing_db_2" {
+  name            = "billing-db"
+  adapter         = "MYSQL"
+  hostname        = "mysql.internal"
+  database        = "billing"
+  restrict_access = false
+  mysql {
+    charset = "utf8mb4"
+  }
+}
+
+resource "querydesk_database" "reporting" {
+  name     = "Reporting"
+  adapter  = "SQLSERVER"
+  hostname = "mssql.internal"
+  database = "reporting"
+  ssl      = true
+  sqlserver {
+    trust_server_certificate = true
+  }
+}
+`, string(files[resourcesFile]))
+
+	assert.Equal(t, `import {
+  to = querydesk_database.billing_db
+  id = "db_1"
+}
+
+import {
+  to = querydesk_database_user.billing_db_readonly
+  id = "cred_1"
+}
+
+import {
+  to = querydesk_database.billing_db_2
+  id = "db_2"
+}
+
+import {
+  to = querydesk_database.reporting
+  id = "db_3"
+}
+`, string(files[importsFile]))
+
+	assert.Equal(t, `variable "billing_db_readonly_password" {
+  description = "Password of the readonly user on the Billing DB database."
+  type        = string
+  sensitive   = true
+}
+`, string(files[variablesFile]))
+}
+
+func TestExport_Paginates(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	firstPage := make([]client.ListDatabasesListDatabasesDatabase, pageSize)
+	for i := range firstPage {
+		firstPage[i] = client.ListDatabasesListDatabasesDatabase{Id: fmt.Sprintf("db_%d", i), Name: "db"}
+	}
+
+	mockClient.EXPECT().ListDatabases(mock.Anything, pageSize, 0).Return(&client.ListDatabasesResponse{
+		ListDatabases: firstPage,
+	}, nil)
+	mockClient.EXPECT().ListDatabases(mock.Anything, pageSize, pageSize).Return(&client.ListDatabasesResponse{
+		ListDatabases: []client.ListDatabasesListDatabasesDatabase{{Id: "db_last", Name: "last"}},
+	}, nil)
+
+	dir := t.TempDir()
+	require.NoError(t, Export(context.Background(), mockClient, dir))
+
+	imports, err := os.ReadFile(filepath.Join(dir, importsFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(imports), `id = "db_99"`)
+	assert.Contains(t, string(imports), `id = "db_last"`)
+}
+
+func TestExport_DoesNotOverwrite(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	mockClient.EXPECT().ListDatabases(mock.Anything, pageSize, 0).Return(&client.ListDatabasesResponse{}, nil)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, resourcesFile), []byte("# mine"), 0600))
+
+	err := Export(context.Background(), mockClient, dir)
+	assert.ErrorContains(t, err, "already exists")
+
+	contents, err := os.ReadFile(filepath.Join(dir, resourcesFile))
+	require.NoError(t, err)
+	assert.Equal(t, "# mine", string(contents))
+}
+
+func TestUniqueLabel(t *testing.T) {
+	used := map[string]bool{}
+
+	assert.Equal(t, "prod_db", uniqueLabel(used, "Prod DB"))
+	assert.Equal(t, "prod_db_2", uniqueLabel(used, "prod-db"))
+	assert.Equal(t, "db_1st", uniqueLabel(used, "1st"))
+	assert.Equal(t, "database", uniqueLabel(used, "!!!"))
+}
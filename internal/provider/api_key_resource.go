@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApiKeyResource{}
+var _ resource.ResourceWithConfigure = &ApiKeyResource{}
+var _ resource.ResourceWithImportState = &ApiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &ApiKeyResource{}
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+// ApiKeyResource defines the resource implementation.
+type ApiKeyResource struct {
+	graphqlClient client.GraphQLClient
+}
+
+// ApiKeyResourceModel describes the resource data model.
+type ApiKeyResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	ReadOnly        types.Bool     `tfsdk:"read_only"`
+	DatabaseIds     types.Set      `tfsdk:"database_ids"`
+	ExpiresAt       types.String   `tfsdk:"expires_at"`
+	RotationTrigger types.String   `tfsdk:"rotation_trigger"`
+	Secret          types.String   `tfsdk:"secret"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A QueryDesk API key, for example to give each CI pipeline its own key limited to what it manages. " +
+			"The secret is only returned when the key is created or rotated.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name shown for the key in the UI.",
+				Required:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the key can only read, without changing anything. Defaults to `false`. Changing it replaces the key.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"database_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the databases the key is limited to. The key can use every database when not set. Changing it replaces the key.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the key stops working, as an RFC 3339 timestamp such as `2030-01-01T00:00:00Z`. The key does not expire when not set. Changing it replaces the key.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value, changing it rotates the secret of the key, e.g. the `id` of a `time_rotating` resource. The previous secret stops working.",
+				Optional:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret to use as the `api_key` of the provider. Only known after the key is created or rotated, it is not set on imported keys.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.graphqlClient = data.client
+}
+
+// ModifyPlan marks the secret as unknown when rotation_trigger changes, as
+// the update rotates it.
+func (r *ApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate when creating or destroying.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateTrigger, planTrigger types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotation_trigger"), &stateTrigger)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_trigger"), &planTrigger)...)
+
+	if resp.Diagnostics.HasError() || planTrigger.Equal(stateTrigger) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret"), types.StringUnknown())...)
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var databaseIds []string
+	if !data.DatabaseIds.IsNull() {
+		resp.Diagnostics.Append(data.DatabaseIds.ElementsAs(ctx, &databaseIds, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	input := client.CreateApiKeyInput{
+		Name:        data.Name.ValueString(),
+		ReadOnly:    data.ReadOnly.ValueBool(),
+		DatabaseIds: databaseIds,
+		ExpiresAt:   data.ExpiresAt.ValueString(),
+	}
+
+	graphqlResp, err := r.graphqlClient.CreateApiKey(ctx, input)
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "creating api key", createTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error creating api key", err, apiKeyInputAttributes) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating api key",
+			"Could not create api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(graphqlResp.CreateApiKey.Result.Id)
+	data.Secret = types.StringValue(graphqlResp.CreateApiKey.Result.Secret)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	graphqlResp, err := r.graphqlClient.GetApiKey(ctx, data.Id.ValueString())
+
+	// Revoked in the UI
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "reading api key", readTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			err.Error(),
+		)
+		return
+	}
+
+	// If id is empty, the resource no longer exists
+	if graphqlResp.ApiKey.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(graphqlResp.ApiKey.Name)
+	data.ReadOnly = types.BoolValue(graphqlResp.ApiKey.ReadOnly)
+
+	if len(graphqlResp.ApiKey.DatabaseIds) > 0 || !data.DatabaseIds.IsNull() {
+		data.DatabaseIds, diags = types.SetValueFrom(ctx, types.StringType, graphqlResp.ApiKey.DatabaseIds)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.ExpiresAt = expiresAtValue(graphqlResp.ApiKey.ExpiresAt, data.ExpiresAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ApiKeyResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !data.Name.Equal(state.Name) {
+		_, err := r.graphqlClient.UpdateApiKey(ctx, data.Id.ValueString(), client.UpdateApiKeyInput{
+			Name: data.Name.ValueString(),
+		})
+
+		if err != nil {
+			if addTimeoutError(&resp.Diagnostics, err, "updating api key", updateTimeout) {
+				return
+			}
+
+			if addValidationError(&resp.Diagnostics, "Error updating api key", err, apiKeyInputAttributes) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error updating api key",
+				"Could not update api key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !data.RotationTrigger.Equal(state.RotationTrigger) {
+		graphqlResp, err := r.graphqlClient.RotateApiKey(ctx, data.Id.ValueString())
+
+		if err != nil {
+			if addTimeoutError(&resp.Diagnostics, err, "rotating api key", updateTimeout) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error rotating api key",
+				"Could not rotate api key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		data.Secret = types.StringValue(graphqlResp.RotateApiKey.Result.Secret)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.graphqlClient.DeleteApiKey(ctx, data.Id.ValueString())
+
+	// Already revoked outside of Terraform
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "deleting api key", deleteTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete api key, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expiresAtValue converts the expiry read from QueryDesk into expires_at. The
+// prior value is kept when it is the same time written differently, e.g. with
+// a +00:00 offset instead of Z, so that it does not show up as drift.
+func expiresAtValue(expiresAt string, prior types.String) types.String {
+	if expiresAt == "" {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorTime, priorErr := time.Parse(time.RFC3339, prior.ValueString())
+		readTime, readErr := time.Parse(time.RFC3339, expiresAt)
+
+		if priorErr == nil && readErr == nil && priorTime.Equal(readTime) {
+			return prior
+		}
+	}
+
+	return types.StringValue(expiresAt)
+}
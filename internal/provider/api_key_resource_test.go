@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccApiKeyResource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const apiKeyId = "key_12345"
+
+	mockClient.EXPECT().CreateApiKey(
+		mock.Anything,
+		client.CreateApiKeyInput{
+			Name:        "ci",
+			ReadOnly:    true,
+			DatabaseIds: []string{"db_12345"},
+			ExpiresAt:   "2030-01-01T00:00:00Z",
+		},
+	).Return(&client.CreateApiKeyResponse{
+		CreateApiKey: client.CreateApiKeyCreateApiKeyCreateApiKeyResult{
+			Result: client.CreateApiKeyCreateApiKeyCreateApiKeyResultResultApiKey{
+				Id:     apiKeyId,
+				Secret: "qd_secret_1",
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetApiKey(
+		mock.Anything,
+		apiKeyId,
+	).Return(&client.GetApiKeyResponse{
+		ApiKey: client.GetApiKeyApiKey{
+			Id:          apiKeyId,
+			Name:        "ci",
+			ReadOnly:    true,
+			DatabaseIds: []string{"db_12345"},
+			ExpiresAt:   "2030-01-01T00:00:00+00:00",
+		},
+	}, nil).Times(3)
+
+	mockClient.EXPECT().UpdateApiKey(
+		mock.Anything,
+		apiKeyId,
+		client.UpdateApiKeyInput{
+			Name: "ci-production",
+		},
+	).Return(&client.UpdateApiKeyResponse{
+		UpdateApiKey: client.UpdateApiKeyUpdateApiKeyUpdateApiKeyResult{
+			Result: client.UpdateApiKeyUpdateApiKeyUpdateApiKeyResultResultApiKey{
+				Id: apiKeyId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().RotateApiKey(
+		mock.Anything,
+		apiKeyId,
+	).Return(&client.RotateApiKeyResponse{
+		RotateApiKey: client.RotateApiKeyRotateApiKeyRotateApiKeyResult{
+			Result: client.RotateApiKeyRotateApiKeyRotateApiKeyResultResultApiKey{
+				Id:     apiKeyId,
+				Secret: "qd_secret_2",
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetApiKey(
+		mock.Anything,
+		apiKeyId,
+	).Return(&client.GetApiKeyResponse{
+		ApiKey: client.GetApiKeyApiKey{
+			Id:          apiKeyId,
+			Name:        "ci-production",
+			ReadOnly:    true,
+			DatabaseIds: []string{"db_12345"},
+			ExpiresAt:   "2030-01-01T00:00:00+00:00",
+		},
+	}, nil)
+
+	mockClient.EXPECT().DeleteApiKey(
+		mock.Anything,
+		apiKeyId,
+	).Return(&client.DeleteApiKeyResponse{
+		DeleteApiKey: client.DeleteApiKeyDeleteApiKeyDeleteApiKeyResult{
+			Result: client.DeleteApiKeyDeleteApiKeyDeleteApiKeyResultResultApiKey{
+				Id: apiKeyId,
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("ci", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_api_key.test", "id", apiKeyId),
+					resource.TestCheckResourceAttr("querydesk_api_key.test", "secret", "qd_secret_1"),
+					resource.TestCheckResourceAttr("querydesk_api_key.test", "expires_at", "2030-01-01T00:00:00Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "querydesk_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "rotation_trigger"},
+			},
+			// Update, rotate and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("ci-production", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_api_key.test", "name", "ci-production"),
+					resource.TestCheckResourceAttr("querydesk_api_key.test", "secret", "qd_secret_2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApiKeyResourceConfig(name string, rotationTrigger string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "querydesk_api_key" "test" {
+  name             = %[1]q
+  read_only        = true
+  database_ids     = ["db_12345"]
+  expires_at       = "2030-01-01T00:00:00Z"
+  rotation_trigger = %[2]q
+}
+`, name, rotationTrigger)
+}
+
+func TestExpiresAtValue(t *testing.T) {
+	tests := map[string]struct {
+		expiresAt string
+		prior     types.String
+		want      types.String
+	}{
+		"no expiry": {
+			expiresAt: "",
+			prior:     types.StringValue("2030-01-01T00:00:00Z"),
+			want:      types.StringNull(),
+		},
+		"same time, different offset": {
+			expiresAt: "2030-01-01T01:00:00+01:00",
+			prior:     types.StringValue("2030-01-01T00:00:00Z"),
+			want:      types.StringValue("2030-01-01T00:00:00Z"),
+		},
+		"changed": {
+			expiresAt: "2031-01-01T00:00:00Z",
+			prior:     types.StringValue("2030-01-01T00:00:00Z"),
+			want:      types.StringValue("2031-01-01T00:00:00Z"),
+		},
+		"imported": {
+			expiresAt: "2030-01-01T00:00:00Z",
+			prior:     types.StringNull(),
+			want:      types.StringValue("2030-01-01T00:00:00Z"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, expiresAtValue(test.expiresAt, test.prior))
+		})
+	}
+}
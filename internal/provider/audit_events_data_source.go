@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-querydesk/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditEventsDataSource{}
+var _ datasource.DataSourceWithConfigure = &AuditEventsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AuditEventsDataSource{}
+
+// auditEventsPageSize is how many events are requested at a time.
+const auditEventsPageSize = 100
+
+// defaultMaxAuditEvents is how many events can be returned unless max_events
+// is set, so that a read without filters does not page through every query
+// ever run.
+const defaultMaxAuditEvents = 10000
+
+// auditEventApprovalStatuses are the approval_status values, matching the
+// QueryDesk enum.
+var auditEventApprovalStatuses = []string{"NOT_REQUIRED", "PENDING", "APPROVED", "REJECTED"}
+
+func NewAuditEventsDataSource() datasource.DataSource {
+	return &AuditEventsDataSource{}
+}
+
+// AuditEventsDataSource lists queries run through QueryDesk.
+type AuditEventsDataSource struct {
+	graphqlClient client.GraphQLClient
+}
+
+// AuditEventsDataSourceModel describes the data source data model.
+type AuditEventsDataSourceModel struct {
+	DatabaseId     types.String      `tfsdk:"database_id"`
+	CredentialId   types.String      `tfsdk:"credential_id"`
+	UserId         types.String      `tfsdk:"user_id"`
+	Since          types.String      `tfsdk:"since"`
+	Until          types.String      `tfsdk:"until"`
+	ApprovalStatus types.String      `tfsdk:"approval_status"`
+	MaxEvents      types.Int64       `tfsdk:"max_events"`
+	Events         []AuditEventModel `tfsdk:"events"`
+	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// AuditEventModel describes an event in the events attribute.
+type AuditEventModel struct {
+	Id             types.String `tfsdk:"id"`
+	DatabaseId     types.String `tfsdk:"database_id"`
+	CredentialId   types.String `tfsdk:"credential_id"`
+	UserId         types.String `tfsdk:"user_id"`
+	Query          types.String `tfsdk:"query"`
+	ApprovalStatus types.String `tfsdk:"approval_status"`
+	OccurredAt     types.String `tfsdk:"occurred_at"`
+}
+
+func (d *AuditEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_events"
+}
+
+func (d *AuditEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Queries run through QueryDesk, oldest first, e.g. for compliance reports. Reading fails if more than `max_events` events match the filters, so narrow the time range for busy databases.",
+
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				MarkdownDescription: "Only return queries run against this database.",
+				Optional:            true,
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "Only return queries run with this `querydesk_database_user`.",
+				Optional:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Only return queries run by this user.",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return queries run at or after this RFC 3339 timestamp, such as `2024-07-01T00:00:00Z`.",
+				Optional:            true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only return queries run before this RFC 3339 timestamp, such as `2024-10-01T00:00:00Z`.",
+				Optional:            true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"approval_status": schema.StringAttribute{
+				MarkdownDescription: "Only return queries with this approval status, one of `NOT_REQUIRED`, `PENDING`, `APPROVED` or `REJECTED`.",
+				Optional:            true,
+				Validators: []validator.String{
+					approvalStatusValidator{},
+				},
+			},
+			"max_events": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The most events to return. Reading fails, rather than returning a partial list, if more events match the filters. Defaults to `%d`.", defaultMaxAuditEvents),
+				Optional:            true,
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching queries, oldest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID",
+							Computed:            true,
+						},
+						"database_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the database the query was run against.",
+							Computed:            true,
+						},
+						"credential_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the `querydesk_database_user` the query was run with.",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the user who ran the query.",
+							Computed:            true,
+						},
+						"query": schema.StringAttribute{
+							MarkdownDescription: "The query that was run.",
+							Computed:            true,
+						},
+						"approval_status": schema.StringAttribute{
+							MarkdownDescription: "The approval status of the query, one of `NOT_REQUIRED`, `PENDING`, `APPROVED` or `REJECTED`.",
+							Computed:            true,
+						},
+						"occurred_at": schema.StringAttribute{
+							MarkdownDescription: "When the query was run, as an RFC 3339 timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *AuditEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.graphqlClient = data.client
+}
+
+func (d *AuditEventsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AuditEventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MaxEvents.IsNull() && !data.MaxEvents.IsUnknown() && data.MaxEvents.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_events"),
+			"Invalid Max Events",
+			fmt.Sprintf("max_events must be at least 1, got: %d.", data.MaxEvents.ValueInt64()),
+		)
+	}
+
+	if data.Since.IsNull() || data.Since.IsUnknown() || data.Until.IsNull() || data.Until.IsUnknown() {
+		return
+	}
+
+	since, sinceErr := time.Parse(time.RFC3339, data.Since.ValueString())
+	until, untilErr := time.Parse(time.RFC3339, data.Until.ValueString())
+
+	// Invalid timestamps are reported by their validators.
+	if sinceErr != nil || untilErr != nil {
+		return
+	}
+
+	if !since.Before(until) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("until"),
+			"Invalid Time Range",
+			fmt.Sprintf("until must be after since, got: %s to %s.", data.Since.String(), data.Until.String()),
+		)
+	}
+}
+
+func (d *AuditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditEventsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	maxEvents := defaultMaxAuditEvents
+	if !data.MaxEvents.IsNull() {
+		maxEvents = int(data.MaxEvents.ValueInt64())
+	}
+
+	events, diags := listAuditEvents(ctx, d.graphqlClient, auditEventFilter(data), maxEvents, readTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Events = events
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAuditEvents pages through the events matching filter, failing if there
+// are more than maxEvents of them. readTimeout covers every page, and is only
+// used to describe timeouts.
+func listAuditEvents(ctx context.Context, c client.GraphQLClient, filter client.AuditEventFilterInput, maxEvents int, readTimeout time.Duration) ([]AuditEventModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	events := []AuditEventModel{}
+
+	for offset := 0; ; offset += auditEventsPageSize {
+		graphqlResp, err := c.ListAuditEvents(ctx, filter, auditEventsPageSize, offset)
+
+		if err != nil {
+			if addTimeoutError(&diags, err, "reading audit events", readTimeout) {
+				return nil, diags
+			}
+
+			diags.AddError(
+				"Unable to Read Audit Events",
+				err.Error(),
+			)
+			return nil, diags
+		}
+
+		for _, event := range graphqlResp.ListAuditEvents {
+			events = append(events, AuditEventModel{
+				Id:             types.StringValue(event.Id),
+				DatabaseId:     types.StringValue(event.DatabaseId),
+				CredentialId:   types.StringValue(event.CredentialId),
+				UserId:         types.StringValue(event.UserId),
+				Query:          types.StringValue(event.Query),
+				ApprovalStatus: types.StringValue(string(event.ApprovalStatus)),
+				OccurredAt:     types.StringValue(event.InsertedAt),
+			})
+		}
+
+		if len(events) > maxEvents {
+			diags.AddAttributeError(
+				path.Root("max_events"),
+				"Too Many Audit Events",
+				fmt.Sprintf("More than %d events match the filters. Narrow the filters, such as the time range, or raise max_events.", maxEvents),
+			)
+			return nil, diags
+		}
+
+		if len(graphqlResp.ListAuditEvents) < auditEventsPageSize {
+			return events, diags
+		}
+	}
+}
+
+// auditEventFilter converts the configured filters into the filter sent to
+// QueryDesk. Filters that are not set are left out.
+func auditEventFilter(data AuditEventsDataSourceModel) client.AuditEventFilterInput {
+	var filter client.AuditEventFilterInput
+
+	if !data.DatabaseId.IsNull() {
+		filter.DatabaseId = &client.AuditEventFilterDatabaseId{Eq: data.DatabaseId.ValueString()}
+	}
+
+	if !data.CredentialId.IsNull() {
+		filter.CredentialId = &client.AuditEventFilterCredentialId{Eq: data.CredentialId.ValueString()}
+	}
+
+	if !data.UserId.IsNull() {
+		filter.UserId = &client.AuditEventFilterUserId{Eq: data.UserId.ValueString()}
+	}
+
+	if !data.Since.IsNull() || !data.Until.IsNull() {
+		filter.InsertedAt = &client.AuditEventFilterInsertedAt{
+			GreaterThanOrEqual: data.Since.ValueString(),
+			LessThan:           data.Until.ValueString(),
+		}
+	}
+
+	if !data.ApprovalStatus.IsNull() {
+		filter.ApprovalStatus = &client.AuditEventFilterApprovalStatus{Eq: client.AuditEventApprovalStatus(data.ApprovalStatus.ValueString())}
+	}
+
+	return filter
+}
+
+type approvalStatusValidator struct{}
+
+func (v approvalStatusValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %s", strings.Join(auditEventApprovalStatuses, ", "))
+}
+
+func (v approvalStatusValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v approvalStatusValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, status := range auditEventApprovalStatuses {
+		if req.ConfigValue.ValueString() == status {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Approval Status",
+		fmt.Sprintf("Expected one of %s, got: %s.", strings.Join(auditEventApprovalStatuses, ", "), req.ConfigValue.String()),
+	)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccAuditEventsDataSource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	filter := client.AuditEventFilterInput{
+		CredentialId: &client.AuditEventFilterCredentialId{Eq: "cred_12345"},
+		InsertedAt: &client.AuditEventFilterInsertedAt{
+			GreaterThanOrEqual: "2024-07-01T00:00:00Z",
+			LessThan:           "2024-10-01T00:00:00Z",
+		},
+		ApprovalStatus: &client.AuditEventFilterApprovalStatus{Eq: client.AuditEventApprovalStatusApproved},
+	}
+
+	// A full first page, so that the second page is requested.
+	firstPage := make([]client.ListAuditEventsListAuditEventsAuditEvent, auditEventsPageSize)
+	for i := range firstPage {
+		firstPage[i] = client.ListAuditEventsListAuditEventsAuditEvent{
+			Id:             fmt.Sprintf("event_%d", i),
+			DatabaseId:     "db_12345",
+			CredentialId:   "cred_12345",
+			UserId:         "user_12345",
+			Query:          "SELECT 1",
+			ApprovalStatus: client.AuditEventApprovalStatusApproved,
+			InsertedAt:     "2024-07-02T00:00:00Z",
+		}
+	}
+
+	mockClient.EXPECT().ListAuditEvents(
+		mock.Anything,
+		filter,
+		auditEventsPageSize,
+		0,
+	).Return(&client.ListAuditEventsResponse{
+		ListAuditEvents: firstPage,
+	}, nil)
+
+	mockClient.EXPECT().ListAuditEvents(
+		mock.Anything,
+		filter,
+		auditEventsPageSize,
+		auditEventsPageSize,
+	).Return(&client.ListAuditEventsResponse{
+		ListAuditEvents: []client.ListAuditEventsListAuditEventsAuditEvent{
+			{
+				Id:             "event_last",
+				DatabaseId:     "db_12345",
+				CredentialId:   "cred_12345",
+				UserId:         "user_67890",
+				Query:          "DELETE FROM users WHERE id = 1",
+				ApprovalStatus: client.AuditEventApprovalStatusApproved,
+				InsertedAt:     "2024-09-30T23:59:59Z",
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "querydesk_audit_events" "test" {
+  credential_id = "cred_12345"
+  since         = "2024-10-01T00:00:00Z"
+  until         = "2024-07-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Time Range"),
+			},
+			{
+				Config: providerConfig + `
+data "querydesk_audit_events" "test" {
+  credential_id = "cred_12345"
+  max_events    = 0
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Max Events"),
+			},
+			{
+				Config: providerConfig + `
+data "querydesk_audit_events" "test" {
+  credential_id   = "cred_12345"
+  since           = "2024-07-01T00:00:00Z"
+  until           = "2024-10-01T00:00:00Z"
+  approval_status = "APPROVED"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.querydesk_audit_events.test", "events.#", "101"),
+					resource.TestCheckResourceAttr("data.querydesk_audit_events.test", "events.100.id", "event_last"),
+					resource.TestCheckResourceAttr("data.querydesk_audit_events.test", "events.100.user_id", "user_67890"),
+					resource.TestCheckResourceAttr("data.querydesk_audit_events.test", "events.100.occurred_at", "2024-09-30T23:59:59Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAuditEventFilter(t *testing.T) {
+	none := AuditEventsDataSourceModel{
+		DatabaseId:     types.StringNull(),
+		CredentialId:   types.StringNull(),
+		UserId:         types.StringNull(),
+		Since:          types.StringNull(),
+		Until:          types.StringNull(),
+		ApprovalStatus: types.StringNull(),
+	}
+
+	assert.Equal(t, client.AuditEventFilterInput{}, auditEventFilter(none))
+
+	since := none
+	since.DatabaseId = types.StringValue("db_12345")
+	since.Since = types.StringValue("2024-07-01T00:00:00Z")
+
+	assert.Equal(t, client.AuditEventFilterInput{
+		DatabaseId: &client.AuditEventFilterDatabaseId{Eq: "db_12345"},
+		InsertedAt: &client.AuditEventFilterInsertedAt{GreaterThanOrEqual: "2024-07-01T00:00:00Z"},
+	}, auditEventFilter(since))
+}
+
+func TestListAuditEvents_MaxEvents(t *testing.T) {
+	page := make([]client.ListAuditEventsListAuditEventsAuditEvent, auditEventsPageSize)
+	for i := range page {
+		page[i] = client.ListAuditEventsListAuditEventsAuditEvent{Id: fmt.Sprintf("event_%d", i)}
+	}
+
+	tests := map[string]struct {
+		maxEvents   int
+		wantEvents  int
+		wantSummary string
+	}{
+		"within limit":   {maxEvents: 200, wantEvents: 150},
+		"at limit":       {maxEvents: 150, wantEvents: 150},
+		"over limit":     {maxEvents: 149, wantSummary: "Too Many Audit Events"},
+		"over on a page": {maxEvents: 99, wantSummary: "Too Many Audit Events"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+
+			mockClient.EXPECT().ListAuditEvents(mock.Anything, client.AuditEventFilterInput{}, auditEventsPageSize, 0).Return(&client.ListAuditEventsResponse{
+				ListAuditEvents: page,
+			}, nil)
+
+			if test.maxEvents >= auditEventsPageSize {
+				mockClient.EXPECT().ListAuditEvents(mock.Anything, client.AuditEventFilterInput{}, auditEventsPageSize, auditEventsPageSize).Return(&client.ListAuditEventsResponse{
+					ListAuditEvents: page[:50],
+				}, nil)
+			}
+
+			events, diags := listAuditEvents(context.Background(), mockClient, client.AuditEventFilterInput{}, test.maxEvents, defaultOperationTimeout)
+
+			if test.wantSummary == "" {
+				require.False(t, diags.HasError(), diags)
+				assert.Len(t, events, test.wantEvents)
+				return
+			}
+
+			require.Len(t, diags, 1)
+			assert.Equal(t, test.wantSummary, diags[0].Summary())
+		})
+	}
+}
+
+func TestListAuditEvents_Timeout(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	mockClient.EXPECT().ListAuditEvents(mock.Anything, client.AuditEventFilterInput{}, auditEventsPageSize, 0).Return(nil, context.DeadlineExceeded)
+
+	_, diags := listAuditEvents(context.Background(), mockClient, client.AuditEventFilterInput{}, defaultMaxAuditEvents, time.Minute)
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Timed out reading audit events", diags[0].Summary())
+}
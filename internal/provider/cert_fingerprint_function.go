@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CertFingerprintFunction{}
+
+func NewCertFingerprintFunction() function.Function {
+	return &CertFingerprintFunction{}
+}
+
+// CertFingerprintFunction returns the SHA-256 fingerprint of a PEM encoded
+// certificate, e.g. to pin the cacertfile of a querydesk_database.
+type CertFingerprintFunction struct{}
+
+func (f *CertFingerprintFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cert_fingerprint"
+}
+
+func (f *CertFingerprintFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Fingerprint a PEM encoded certificate",
+		MarkdownDescription: "Returns the SHA-256 fingerprint of the first certificate in a PEM encoded string, " +
+			"as colon separated uppercase hex like `openssl x509 -noout -fingerprint -sha256`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pem",
+				MarkdownDescription: "One or more PEM encoded certificates, e.g. the contents of a `cacertfile`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CertFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certPEM string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &certPEM))
+
+	if resp.Error != nil {
+		return
+	}
+
+	fingerprint, err := certFingerprint(certPEM)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fingerprint))
+}
+
+func certFingerprint(certPEM string) (string, error) {
+	rest := []byte(certPEM)
+
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+
+		if block == nil {
+			return "", fmt.Errorf("no PEM encoded certificate found")
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return "", fmt.Errorf("parsing certificate: %w", err)
+		}
+
+		sum := sha256.Sum256(block.Bytes)
+		hex := make([]string, len(sum))
+		for i, b := range sum {
+			hex[i] = fmt.Sprintf("%02X", b)
+		}
+
+		return strings.Join(hex, ":"), nil
+	}
+}
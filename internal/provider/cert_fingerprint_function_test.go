@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificate(t *testing.T) (der []byte, certPEM string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "db.internal"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return der, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCertFingerprintFunction(t *testing.T) {
+	der, certPEM := testCertificate(t)
+
+	sum := sha256.Sum256(der)
+	want := strings.ToUpper(strings.TrimSuffix(strings.ReplaceAll(fmt.Sprintf("% x", sum), " ", ":"), ":"))
+
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("not a key")}))
+
+	tests := map[string]struct {
+		pem     string
+		want    string
+		wantErr string
+	}{
+		"certificate":            {pem: certPEM, want: want},
+		"key before certificate": {pem: keyPEM + certPEM, want: want},
+		"bundle":                 {pem: certPEM + certPEM, want: want},
+		"empty":                  {pem: "", wantErr: "no PEM encoded certificate found"},
+		"no certificate":         {pem: keyPEM, wantErr: "no PEM encoded certificate found"},
+		"invalid certificate": {
+			pem:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})),
+			wantErr: "parsing certificate",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewCertFingerprintFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(test.pem)}),
+			}, &resp)
+
+			if test.wantErr != "" {
+				if assert.NotNil(t, resp.Error) {
+					assert.Contains(t, resp.Error.Error(), test.wantErr)
+				}
+				return
+			}
+
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.StringValue(test.want), resp.Result.Value())
+		})
+	}
+}
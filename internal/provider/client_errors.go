@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// databaseInputAttributes maps the fields of CreateDatabaseInput and
+// UpdateDatabaseInput to the querydesk_database attributes they are set from.
+var databaseInputAttributes = map[string]path.Path{
+	"name":             path.Root("name"),
+	"adapter":          path.Root("adapter"),
+	"hostname":         path.Root("hostname"),
+	"database":         path.Root("database"),
+	"ssl":              path.Root("ssl"),
+	"restrictAccess":   path.Root("restrict_access"),
+	"cacertfile":       path.Root("cacertfile"),
+	"newCacertfile":    path.Root("cacertfile"),
+	"keyfile":          path.Root("keyfile"),
+	"newKeyfile":       path.Root("keyfile"),
+	"certfile":         path.Root("certfile"),
+	"newCertfile":      path.Root("certfile"),
+	"postgresOptions":  path.Root("postgres"),
+	"mysqlOptions":     path.Root("mysql"),
+	"sqlserverOptions": path.Root("sqlserver"),
+}
+
+// credentialInputAttributes maps the fields of CreateCredentialInput and
+// UpdateCredentialInput to the querydesk_database_user attributes they are
+// set from.
+var credentialInputAttributes = map[string]path.Path{
+	"databaseId":      path.Root("database_id"),
+	"description":     path.Root("description"),
+	"username":        path.Root("username"),
+	"reviewsRequired": path.Root("reviews_required"),
+	"password":        path.Root("password"),
+	"newPassword":     path.Root("password"),
+	"reviewPolicyId":  path.Root("review_policy_id"),
+}
+
+// databaseAccessInputAttributes maps the fields of CreateDatabaseAccessInput
+// and UpdateDatabaseAccessInput to the querydesk_database_access attributes
+// they are set from.
+var databaseAccessInputAttributes = map[string]path.Path{
+	"databaseId":    path.Root("database_id"),
+	"userId":        path.Root("user_id"),
+	"teamId":        path.Root("team_id"),
+	"credentialIds": path.Root("credential_ids"),
+}
+
+// reviewPolicyInputAttributes maps the fields of CreateReviewPolicyInput and
+// UpdateReviewPolicyInput to the querydesk_review_policy attributes they are
+// set from.
+var reviewPolicyInputAttributes = map[string]path.Path{
+	"name":              path.Root("name"),
+	"reviewerTeamId":    path.Root("reviewer_team_id"),
+	"allowSelfApproval": path.Root("allow_self_approval"),
+	"readOnlyBypass":    path.Root("read_only_bypass"),
+}
+
+// userInputAttributes maps the fields of CreateUserInput and UpdateUserInput
+// to the querydesk_user attributes they are set from.
+var userInputAttributes = map[string]path.Path{
+	"email": path.Root("email"),
+	"name":  path.Root("name"),
+	"role":  path.Root("role"),
+}
+
+// teamInputAttributes maps the fields of CreateTeamInput and UpdateTeamInput
+// to the querydesk_team attributes they are set from.
+var teamInputAttributes = map[string]path.Path{
+	"name":        path.Root("name"),
+	"description": path.Root("description"),
+}
+
+// teamMembershipInputAttributes maps the fields of CreateTeamMembershipInput
+// to the querydesk_team_membership attributes they are set from.
+var teamMembershipInputAttributes = map[string]path.Path{
+	"teamId": path.Root("team_id"),
+	"userId": path.Root("user_id"),
+}
+
+// apiKeyInputAttributes maps the fields of CreateApiKeyInput and
+// UpdateApiKeyInput to the querydesk_api_key attributes they are set from.
+var apiKeyInputAttributes = map[string]path.Path{
+	"name":        path.Root("name"),
+	"readOnly":    path.Root("read_only"),
+	"databaseIds": path.Root("database_ids"),
+	"expiresAt":   path.Root("expires_at"),
+}
+
+// addValidationError reports input rejected by the QueryDesk API against the
+// attributes it came from, returning true if err was a validation error.
+// attributes maps API input fields to attribute paths.
+func addValidationError(diags *diag.Diagnostics, summary string, err error, attributes map[string]path.Path) bool {
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+
+	reported := false
+
+	for _, field := range validationErr.Fields {
+		if attribute, ok := attributes[field]; ok {
+			diags.AddAttributeError(attribute, summary, "The QueryDesk API rejected this value: "+validationErr.Message)
+			reported = true
+		}
+	}
+
+	if !reported {
+		diags.AddError(summary, "The QueryDesk API rejected the configuration: "+err.Error())
+	}
+
+	return true
+}
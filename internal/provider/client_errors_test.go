@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddValidationError(t *testing.T) {
+	var diags diag.Diagnostics
+	assert.False(t, addValidationError(&diags, "Error creating database", errors.New("connection refused"), databaseInputAttributes))
+	assert.Empty(t, diags)
+
+	err := fmt.Errorf("%w (request id: 1)", &client.ValidationError{
+		Message: "has already been taken",
+		Code:    "invalid_attribute",
+		Fields:  []string{"restrictAccess"},
+	})
+	require.True(t, addValidationError(&diags, "Error creating database", err, databaseInputAttributes))
+	require.Len(t, diags, 1)
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, path.Root("restrict_access"), withPath.Path())
+	assert.Contains(t, diags[0].Detail(), "has already been taken")
+
+	diags = nil
+	err = &client.ValidationError{Message: "is invalid", Fields: []string{"unknownField"}}
+	require.True(t, addValidationError(&diags, "Error creating database", err, databaseInputAttributes))
+	require.Len(t, diags, 1)
+	_, ok = diags[0].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+}
@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-querydesk/internal/client"
+	"terraform-provider-querydesk/internal/dbconn"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatabasePostgresModel describes the postgres block of a database.
+type DatabasePostgresModel struct {
+	Sslmode    types.String `tfsdk:"sslmode"`
+	SearchPath types.String `tfsdk:"search_path"`
+}
+
+// DatabaseMysqlModel describes the mysql block of a database.
+type DatabaseMysqlModel struct {
+	Charset   types.String `tfsdk:"charset"`
+	Collation types.String `tfsdk:"collation"`
+}
+
+// DatabaseSqlserverModel describes the sqlserver block of a database.
+type DatabaseSqlserverModel struct {
+	TrustServerCertificate types.Bool `tfsdk:"trust_server_certificate"`
+}
+
+// postgresSslmodes are the libpq sslmode values, in the form used in
+// connection strings.
+var postgresSslmodes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+var searchPathSchema = regexp.MustCompile(`^("[^"]+"|\$user|[A-Za-z_][A-Za-z0-9_$]*)$`)
+
+// splitSearchPath splits a comma separated search_path into its schemas.
+func splitSearchPath(searchPath string) []string {
+	schemas := strings.Split(searchPath, ",")
+	for i, schema := range schemas {
+		schemas[i] = strings.TrimSpace(schema)
+	}
+
+	return schemas
+}
+
+// validateSearchPath checks that searchPath is a comma separated list of
+// schema names, each a plain or double quoted Postgres identifier.
+func validateSearchPath(searchPath string) error {
+	for _, schema := range splitSearchPath(searchPath) {
+		if schema == "" {
+			return fmt.Errorf("%q must be a comma separated list of schemas without empty entries", searchPath)
+		}
+
+		if !searchPathSchema.MatchString(schema) {
+			return fmt.Errorf("%q is not a valid schema name, quote names that are not plain identifiers", schema)
+		}
+
+		if len(strings.Trim(schema, `"`)) > 63 {
+			return fmt.Errorf("%q is longer than 63 bytes", schema)
+		}
+	}
+
+	return nil
+}
+
+var mysqlName = regexp.MustCompile(`^[A-Za-z0-9_]{1,64}$`)
+
+// validateMysqlCollation checks that collation belongs to charset, e.g.
+// utf8mb4_unicode_ci to utf8mb4.
+func validateMysqlCollation(charset string, collation string) error {
+	charset = strings.ToLower(charset)
+	collation = strings.ToLower(collation)
+
+	if collation == charset && charset == "binary" {
+		return nil
+	}
+
+	if !strings.HasPrefix(collation, charset+"_") {
+		return fmt.Errorf("collation %q does not belong to charset %q", collation, charset)
+	}
+
+	return nil
+}
+
+// validateConnectionOptions checks that at most one of the postgres, mysql
+// and sqlserver blocks is set, that it matches the adapter and that it agrees
+// with ssl. Unknown values are skipped, to be checked once they are known.
+func validateConnectionOptions(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var adapter types.String
+	var ssl types.Bool
+	var postgres, mysql, sqlserver types.Object
+
+	diags.Append(config.GetAttribute(ctx, path.Root("adapter"), &adapter)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("ssl"), &ssl)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("postgres"), &postgres)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("mysql"), &mysql)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("sqlserver"), &sqlserver)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var block, blockAdapter string
+	for _, option := range []struct {
+		value   types.Object
+		block   string
+		adapter string
+	}{
+		{postgres, "postgres", "POSTGRES"},
+		{mysql, "mysql", "MYSQL"},
+		{sqlserver, "sqlserver", "SQLSERVER"},
+	} {
+		if option.value.IsNull() {
+			continue
+		}
+
+		if block != "" {
+			diags.AddAttributeError(
+				path.Root(option.block),
+				"Conflicting Connection Options",
+				"Only one of the `postgres`, `mysql` and `sqlserver` blocks can be set, matching the adapter.",
+			)
+			return diags
+		}
+
+		block, blockAdapter = option.block, option.adapter
+	}
+
+	if block != "" && !adapter.IsNull() && !adapter.IsUnknown() && adapter.ValueString() != blockAdapter {
+		diags.AddAttributeError(
+			path.Root(block),
+			"Invalid Connection Options",
+			fmt.Sprintf("The `%s` block can only be used with adapter `%s`, got: %s.", block, blockAdapter, adapter.String()),
+		)
+	}
+
+	if !sqlserver.IsNull() && !sqlserver.IsUnknown() && !ssl.IsUnknown() {
+		var trustServerCertificate types.Bool
+		diags.Append(config.GetAttribute(ctx, path.Root("sqlserver").AtName("trust_server_certificate"), &trustServerCertificate)...)
+
+		// ssl defaults to false when not configured.
+		if trustServerCertificate.ValueBool() && !ssl.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("sqlserver").AtName("trust_server_certificate"),
+				"Invalid Connection Options",
+				"`trust_server_certificate` requires `ssl` to be set to `true`.",
+			)
+		}
+	}
+
+	if postgres.IsNull() || postgres.IsUnknown() || ssl.IsUnknown() {
+		return diags
+	}
+
+	var sslmode types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("postgres").AtName("sslmode"), &sslmode)...)
+
+	if sslmode.IsNull() || sslmode.IsUnknown() {
+		return diags
+	}
+
+	// ssl defaults to false when not configured.
+	switch mode := sslmode.ValueString(); {
+	case ssl.ValueBool() && mode == "disable":
+		diags.AddAttributeError(
+			path.Root("postgres").AtName("sslmode"),
+			"Invalid Connection Options",
+			"sslmode `disable` cannot be used with `ssl` set to `true`.",
+		)
+	case !ssl.ValueBool() && (mode == "require" || mode == "verify-ca" || mode == "verify-full"):
+		diags.AddAttributeError(
+			path.Root("postgres").AtName("sslmode"),
+			"Invalid Connection Options",
+			fmt.Sprintf("sslmode `%s` requires `ssl` to be set to `true`.", mode),
+		)
+	}
+
+	return diags
+}
+
+// postgresOptionsInput converts the postgres block into the options sent to
+// QueryDesk, or nil if the block is not set.
+func postgresOptionsInput(data *DatabasePostgresModel) *client.DatabasePostgresOptionsInput {
+	if data == nil {
+		return nil
+	}
+
+	input := &client.DatabasePostgresOptionsInput{
+		SearchPath: data.SearchPath.ValueString(),
+	}
+
+	if !data.Sslmode.IsNull() {
+		input.Sslmode = dbconn.PostgresSslmodeEnum(data.Sslmode.ValueString())
+	}
+
+	return input
+}
+
+// mysqlOptionsInput converts the mysql block into the options sent to
+// QueryDesk, or nil if the block is not set.
+func mysqlOptionsInput(data *DatabaseMysqlModel) *client.DatabaseMysqlOptionsInput {
+	if data == nil {
+		return nil
+	}
+
+	return &client.DatabaseMysqlOptionsInput{
+		Charset:   data.Charset.ValueString(),
+		Collation: data.Collation.ValueString(),
+	}
+}
+
+// sqlserverOptionsInput converts the sqlserver block into the options sent to
+// QueryDesk, or nil if the block is not set.
+func sqlserverOptionsInput(data *DatabaseSqlserverModel) *client.DatabaseSqlserverOptionsInput {
+	if data == nil {
+		return nil
+	}
+
+	return &client.DatabaseSqlserverOptionsInput{
+		TrustServerCertificate: data.TrustServerCertificate.ValueBoolPointer(),
+	}
+}
+
+// postgresOptionsModel converts the options read from QueryDesk into the
+// postgres block. An empty block is kept if one was set before, so that it
+// does not show up as drift.
+func postgresOptionsModel(sslmode client.DatabasePostgresSslmode, searchPath string, prior *DatabasePostgresModel) *DatabasePostgresModel {
+	if sslmode == "" && searchPath == "" && prior == nil {
+		return nil
+	}
+
+	data := &DatabasePostgresModel{
+		Sslmode:    types.StringNull(),
+		SearchPath: types.StringNull(),
+	}
+
+	if sslmode != "" {
+		data.Sslmode = types.StringValue(dbconn.PostgresSslmodeValue(sslmode))
+	}
+
+	if searchPath != "" {
+		data.SearchPath = types.StringValue(searchPath)
+	}
+
+	return data
+}
+
+// mysqlOptionsModel converts the options read from QueryDesk into the mysql
+// block. An empty block is kept if one was set before, so that it does not
+// show up as drift.
+func mysqlOptionsModel(charset string, collation string, prior *DatabaseMysqlModel) *DatabaseMysqlModel {
+	if charset == "" && collation == "" && prior == nil {
+		return nil
+	}
+
+	data := &DatabaseMysqlModel{
+		Charset:   types.StringNull(),
+		Collation: types.StringNull(),
+	}
+
+	if charset != "" {
+		data.Charset = types.StringValue(charset)
+	}
+
+	if collation != "" {
+		data.Collation = types.StringValue(collation)
+	}
+
+	return data
+}
+
+// sqlserverOptionsModel converts the options read from QueryDesk into the
+// sqlserver block. An empty block is kept if one was set before, so that it
+// does not show up as drift.
+func sqlserverOptionsModel(trustServerCertificate bool, prior *DatabaseSqlserverModel) *DatabaseSqlserverModel {
+	if !trustServerCertificate && prior == nil {
+		return nil
+	}
+
+	data := &DatabaseSqlserverModel{
+		TrustServerCertificate: types.BoolNull(),
+	}
+
+	// QueryDesk reads an unset option as false.
+	if trustServerCertificate || (prior != nil && !prior.TrustServerCertificate.IsNull()) {
+		data.TrustServerCertificate = types.BoolValue(trustServerCertificate)
+	}
+
+	return data
+}
+
+type postgresSslmodeValidator struct{}
+
+func (v postgresSslmodeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %s", strings.Join(postgresSslmodes, ", "))
+}
+
+func (v postgresSslmodeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v postgresSslmodeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, sslmode := range postgresSslmodes {
+		if req.ConfigValue.ValueString() == sslmode {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid sslmode",
+		fmt.Sprintf("Expected one of %s, got: %s.", strings.Join(postgresSslmodes, ", "), req.ConfigValue.String()),
+	)
+}
+
+type searchPathValidator struct{}
+
+func (v searchPathValidator) Description(ctx context.Context) string {
+	return "value must be a comma separated list of schema names"
+}
+
+func (v searchPathValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v searchPathValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateSearchPath(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Search Path", err.Error())
+	}
+}
+
+// mysqlNameValidator checks charset and collation names. Collations are also
+// checked against the charset of the same block, if set.
+type mysqlNameValidator struct {
+	collation bool
+}
+
+func (v mysqlNameValidator) Description(ctx context.Context) string {
+	if v.collation {
+		return "value must be a MySQL collation name belonging to charset"
+	}
+
+	return "value must be a MySQL charset name"
+}
+
+func (v mysqlNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mysqlNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !mysqlName.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MySQL Option",
+			fmt.Sprintf("Expected up to 64 letters, digits or underscores, got: %s.", req.ConfigValue.String()),
+		)
+		return
+	}
+
+	if !v.collation {
+		return
+	}
+
+	var charset types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("charset"), &charset)...)
+
+	if charset.IsNull() || charset.IsUnknown() {
+		return
+	}
+
+	if err := validateMysqlCollation(charset.ValueString(), req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid MySQL Option", err.Error())
+	}
+}
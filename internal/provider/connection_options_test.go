@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSearchPath(t *testing.T) {
+	valid := []string{
+		"public",
+		"app, public",
+		`"$user", public`,
+		`$user,"My Schema",app_2`,
+	}
+
+	for _, searchPath := range valid {
+		assert.NoError(t, validateSearchPath(searchPath), searchPath)
+	}
+
+	invalid := map[string]string{
+		"":                  "without empty entries",
+		"app,,public":       "without empty entries",
+		"app public":        "not a valid schema name",
+		"2fa":               "not a valid schema name",
+		"app; drop table x": "not a valid schema name",
+		"a23456789012345678901234567890123456789012345678901234567890abcd": "longer than 63 bytes",
+	}
+
+	for searchPath, want := range invalid {
+		assert.ErrorContains(t, validateSearchPath(searchPath), want, searchPath)
+	}
+}
+
+func TestValidateMysqlCollation(t *testing.T) {
+	assert.NoError(t, validateMysqlCollation("utf8mb4", "utf8mb4_unicode_ci"))
+	assert.NoError(t, validateMysqlCollation("UTF8MB4", "utf8mb4_0900_ai_ci"))
+	assert.NoError(t, validateMysqlCollation("binary", "binary"))
+	assert.ErrorContains(t, validateMysqlCollation("latin1", "utf8mb4_unicode_ci"), `does not belong to charset "latin1"`)
+	assert.ErrorContains(t, validateMysqlCollation("utf8", "utf8mb4_unicode_ci"), "does not belong")
+}
+
+func TestValidateConnectionOptions(t *testing.T) {
+	tests := map[string]struct {
+		values      map[string]tftypes.Value
+		wantSummary string
+	}{
+		"postgres": {
+			values: map[string]tftypes.Value{
+				"adapter":  tftypes.NewValue(tftypes.String, "POSTGRES"),
+				"ssl":      tftypes.NewValue(tftypes.Bool, true),
+				"postgres": testPostgresBlock("verify-full"),
+			},
+		},
+		"no options": {
+			values: map[string]tftypes.Value{
+				"adapter": tftypes.NewValue(tftypes.String, "SQLSERVER"),
+			},
+		},
+		"unknown adapter": {
+			values: map[string]tftypes.Value{
+				"adapter":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"postgres": testPostgresBlock("prefer"),
+			},
+		},
+		"both blocks": {
+			values: map[string]tftypes.Value{
+				"adapter":  tftypes.NewValue(tftypes.String, "POSTGRES"),
+				"postgres": testPostgresBlock("prefer"),
+				"mysql":    testMysqlBlock(),
+			},
+			wantSummary: "Conflicting Connection Options",
+		},
+		"postgres block on mysql": {
+			values: map[string]tftypes.Value{
+				"adapter":  tftypes.NewValue(tftypes.String, "MYSQL"),
+				"postgres": testPostgresBlock("prefer"),
+			},
+			wantSummary: "Invalid Connection Options",
+		},
+		"mysql block on sqlserver": {
+			values: map[string]tftypes.Value{
+				"adapter": tftypes.NewValue(tftypes.String, "SQLSERVER"),
+				"mysql":   testMysqlBlock(),
+			},
+			wantSummary: "Invalid Connection Options",
+		},
+		"sqlserver": {
+			values: map[string]tftypes.Value{
+				"adapter":   tftypes.NewValue(tftypes.String, "SQLSERVER"),
+				"ssl":       tftypes.NewValue(tftypes.Bool, true),
+				"sqlserver": testSqlserverBlock(true),
+			},
+		},
+		"sqlserver block on postgres": {
+			values: map[string]tftypes.Value{
+				"adapter":   tftypes.NewValue(tftypes.String, "POSTGRES"),
+				"sqlserver": testSqlserverBlock(false),
+			},
+			wantSummary: "Invalid Connection Options",
+		},
+		"trust_server_certificate without ssl": {
+			values: map[string]tftypes.Value{
+				"adapter":   tftypes.NewValue(tftypes.String, "SQLSERVER"),
+				"sqlserver": testSqlserverBlock(true),
+			},
+			wantSummary: "Invalid Connection Options",
+		},
+		"verify-full without ssl": {
+			values: map[string]tftypes.Value{
+				"adapter":  tftypes.NewValue(tftypes.String, "POSTGRES"),
+				"postgres": testPostgresBlock("verify-full"),
+			},
+			wantSummary: "Invalid Connection Options",
+		},
+		"disable with ssl": {
+			values: map[string]tftypes.Value{
+				"adapter":  tftypes.NewValue(tftypes.String, "POSTGRES"),
+				"ssl":      tftypes.NewValue(tftypes.Bool, true),
+				"postgres": testPostgresBlock("disable"),
+			},
+			wantSummary: "Invalid Connection Options",
+		},
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	NewDatabaseResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				if value, ok := test.values[name]; ok {
+					attributes[name] = value
+				} else {
+					attributes[name] = tftypes.NewValue(attributeType, nil)
+				}
+			}
+
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, attributes),
+			}
+
+			diags := validateConnectionOptions(context.Background(), config)
+
+			if test.wantSummary == "" {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+
+			require.Len(t, diags, 1)
+			assert.Equal(t, test.wantSummary, diags[0].Summary())
+		})
+	}
+}
+
+func testPostgresBlock(sslmode string) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"sslmode":     tftypes.String,
+		"search_path": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"sslmode":     tftypes.NewValue(tftypes.String, sslmode),
+		"search_path": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func testMysqlBlock() tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"charset":   tftypes.String,
+		"collation": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"charset":   tftypes.NewValue(tftypes.String, "utf8mb4"),
+		"collation": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func testSqlserverBlock(trustServerCertificate bool) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"trust_server_certificate": tftypes.Bool,
+	}}, map[string]tftypes.Value{
+		"trust_server_certificate": tftypes.NewValue(tftypes.Bool, trustServerCertificate),
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"gopkg.in/ini.v1"
+)
+
+const (
+	hostEnv            = "QUERYDESK_HOST"
+	apiKeyEnv          = "QUERYDESK_API_KEY"
+	profileEnv         = "QUERYDESK_PROFILE"
+	credentialsFileEnv = "QUERYDESK_CREDENTIALS_FILE"
+
+	defaultProfile = "default"
+)
+
+// profileNotFoundError is returned when the credentials file has no section
+// for the profile.
+type profileNotFoundError struct {
+	name string
+	file string
+}
+
+func (e profileNotFoundError) Error() string {
+	return fmt.Sprintf("profile %q not found in %s", e.name, e.file)
+}
+
+// credentialsProfile is a named section of the QueryDesk credentials file, e.g.
+//
+//	[staging]
+//	host    = https://staging.querydesk.internal
+//	api_key = SFMyNTY...
+type credentialsProfile struct {
+	Host   string `ini:"host"`
+	ApiKey string `ini:"api_key"`
+}
+
+// credentialsFilePath returns the location of the credentials file, which
+// defaults to ~/.querydesk/credentials.
+func credentialsFilePath(getenv func(string) string) (string, error) {
+	if file := getenv(credentialsFileEnv); file != "" {
+		return file, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".querydesk", "credentials"), nil
+}
+
+// loadCredentialsProfile reads the named profile from the credentials file at
+// file. A nil profile is returned without error if the file does not exist.
+func loadCredentialsProfile(file string, name string) (*credentialsProfile, error) {
+	cfg, err := ini.Load(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", file, err)
+	}
+
+	section, err := cfg.GetSection(name)
+	if err != nil {
+		return nil, profileNotFoundError{name: name, file: file}
+	}
+
+	var profile credentialsProfile
+	if err := section.MapTo(&profile); err != nil {
+		return nil, fmt.Errorf("reading profile %q from %s: %w", name, file, err)
+	}
+
+	return &profile, nil
+}
+
+// resolveCredentials determines the host and api key to use. Values set in the
+// provider configuration take precedence over the QUERYDESK_HOST and
+// QUERYDESK_API_KEY environment variables, which take precedence over the
+// selected profile of the credentials file.
+func resolveCredentials(data QueryDeskProviderModel, getenv func(string) string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	profileName := getenv(profileEnv)
+	explicitProfile := profileName != ""
+	if !data.Profile.IsNull() {
+		profileName = data.Profile.ValueString()
+		explicitProfile = true
+	}
+	if profileName == "" {
+		profileName = defaultProfile
+	}
+
+	var profile credentialsProfile
+
+	file, err := credentialsFilePath(getenv)
+	if err == nil {
+		var loaded *credentialsProfile
+		loaded, err = loadCredentialsProfile(file, profileName)
+		if err == nil && loaded == nil && explicitProfile {
+			err = fmt.Errorf("credentials file %s does not exist", file)
+		}
+		if loaded != nil {
+			profile = *loaded
+		}
+	}
+
+	// The default profile is optional, but a file that cannot be parsed should
+	// not be ignored without a trace.
+	var notFound profileNotFoundError
+	if err != nil && !explicitProfile && file != "" && !errors.As(err, &notFound) {
+		diags.AddWarning(
+			"Unable to Read QueryDesk Credentials File",
+			fmt.Sprintf("The provider ignored the QueryDesk credentials file, so the %q profile was not used: %s", profileName, err),
+		)
+	}
+
+	// A missing default profile is fine, but one the user asked for is not.
+	if err != nil && explicitProfile {
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Load QueryDesk Profile",
+			fmt.Sprintf("The provider cannot load the %q profile from the QueryDesk credentials file: %s", profileName, err),
+		)
+		return "", "", diags
+	}
+
+	host := firstNonEmpty(data.Host.ValueString(), getenv(hostEnv), profile.Host)
+	apiKey := firstNonEmpty(data.ApiKey.ValueString(), getenv(apiKeyEnv), profile.ApiKey)
+
+	return host, apiKey, diags
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentialsFile = `
+[default]
+host    = https://default.querydesk.internal
+api_key = default-key
+
+[staging]
+host    = https://staging.querydesk.internal
+api_key = staging-key
+`
+
+func testGetenv(t *testing.T, env map[string]string) func(string) string {
+	file := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(file, []byte(testCredentialsFile), 0600))
+
+	return func(key string) string {
+		if value, ok := env[key]; ok {
+			return value
+		}
+		if key == credentialsFileEnv {
+			return file
+		}
+		return ""
+	}
+}
+
+func testProviderModel(host, apiKey, profile *string) QueryDeskProviderModel {
+	return QueryDeskProviderModel{
+		Host:    types.StringPointerValue(host),
+		ApiKey:  types.StringPointerValue(apiKey),
+		Profile: types.StringPointerValue(profile),
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestResolveCredentials_ConfigOverridesEnvAndProfile(t *testing.T) {
+	getenv := testGetenv(t, map[string]string{
+		hostEnv:    "https://env.querydesk.internal",
+		apiKeyEnv:  "env-key",
+		profileEnv: "staging",
+	})
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(ptr("https://config.querydesk.internal"), ptr("config-key"), nil), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://config.querydesk.internal", host)
+	assert.Equal(t, "config-key", apiKey)
+}
+
+func TestResolveCredentials_EnvOverridesProfile(t *testing.T) {
+	getenv := testGetenv(t, map[string]string{
+		hostEnv:   "https://env.querydesk.internal",
+		apiKeyEnv: "env-key",
+	})
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(nil, nil, ptr("staging")), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://env.querydesk.internal", host)
+	assert.Equal(t, "env-key", apiKey)
+}
+
+func TestResolveCredentials_MixedSources(t *testing.T) {
+	getenv := testGetenv(t, map[string]string{
+		apiKeyEnv: "env-key",
+	})
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(nil, nil, ptr("staging")), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://staging.querydesk.internal", host)
+	assert.Equal(t, "env-key", apiKey)
+}
+
+func TestResolveCredentials_DefaultProfile(t *testing.T) {
+	getenv := testGetenv(t, nil)
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(nil, nil, nil), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://default.querydesk.internal", host)
+	assert.Equal(t, "default-key", apiKey)
+}
+
+func TestResolveCredentials_ProfileFromEnv(t *testing.T) {
+	getenv := testGetenv(t, map[string]string{
+		profileEnv: "staging",
+	})
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(nil, nil, nil), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://staging.querydesk.internal", host)
+	assert.Equal(t, "staging-key", apiKey)
+}
+
+func TestResolveCredentials_ProfileConfigOverridesEnv(t *testing.T) {
+	getenv := testGetenv(t, map[string]string{
+		profileEnv: "staging",
+	})
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(nil, nil, ptr("default")), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://default.querydesk.internal", host)
+	assert.Equal(t, "default-key", apiKey)
+}
+
+func TestResolveCredentials_MissingDefaultFile(t *testing.T) {
+	getenv := func(key string) string {
+		if key == credentialsFileEnv {
+			return filepath.Join(t.TempDir(), "credentials")
+		}
+		return ""
+	}
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(nil, nil, nil), getenv)
+
+	require.False(t, diags.HasError())
+	assert.Empty(t, host)
+	assert.Empty(t, apiKey)
+}
+
+func TestResolveCredentials_MissingExplicitProfile(t *testing.T) {
+	getenv := testGetenv(t, nil)
+
+	_, _, diags := resolveCredentials(testProviderModel(nil, nil, ptr("production")), getenv)
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), `profile "production" not found`)
+}
+
+func TestResolveCredentials_MissingFileForExplicitProfile(t *testing.T) {
+	getenv := func(key string) string {
+		switch key {
+		case credentialsFileEnv:
+			return filepath.Join(t.TempDir(), "credentials")
+		case profileEnv:
+			return "staging"
+		}
+		return ""
+	}
+
+	_, _, diags := resolveCredentials(testProviderModel(nil, nil, nil), getenv)
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "does not exist")
+}
+
+func TestResolveCredentials_MalformedFileForDefaultProfile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(file, []byte("[default\nhost = https://default.querydesk.internal\n"), 0600))
+
+	getenv := func(key string) string {
+		if key == credentialsFileEnv {
+			return file
+		}
+		return ""
+	}
+
+	host, apiKey, diags := resolveCredentials(testProviderModel(ptr("https://config.querydesk.internal"), ptr("config-key"), nil), getenv)
+
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Equal(t, "Unable to Read QueryDesk Credentials File", diags[0].Summary())
+	assert.Equal(t, "https://config.querydesk.internal", host)
+	assert.Equal(t, "config-key", apiKey)
+}
+
+func TestResolveCredentials_DefaultProfileNotInFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(file, []byte("[staging]\nhost = https://staging.querydesk.internal\n"), 0600))
+
+	getenv := func(key string) string {
+		if key == credentialsFileEnv {
+			return file
+		}
+		return ""
+	}
+
+	_, _, diags := resolveCredentials(testProviderModel(nil, nil, nil), getenv)
+
+	assert.Empty(t, diags)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CurrentIdentityDataSource{}
+var _ datasource.DataSourceWithConfigure = &CurrentIdentityDataSource{}
+
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &CurrentIdentityDataSource{}
+}
+
+// CurrentIdentityDataSource reports the API key the provider is configured
+// with.
+type CurrentIdentityDataSource struct {
+	graphqlClient client.GraphQLClient
+}
+
+// CurrentIdentityDataSourceModel describes the data source data model.
+type CurrentIdentityDataSourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	ReadOnly    types.Bool     `tfsdk:"read_only"`
+	DatabaseIds types.Set      `tfsdk:"database_ids"`
+	ExpiresAt   types.String   `tfsdk:"expires_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *CurrentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+func (d *CurrentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The scope of the API key the provider is configured with, e.g. to check that a pipeline does not run with an admin key.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the API key.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the API key.",
+			},
+			"read_only": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the key can only read, without changing anything.",
+			},
+			"database_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Identifiers of the databases the key is limited to, empty when the key can use every database.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the key stops working, as an RFC 3339 timestamp. Not set when the key does not expire.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *CurrentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.graphqlClient = data.client
+}
+
+func (d *CurrentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentIdentityDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	graphqlResp, err := d.graphqlClient.GetCurrentApiKey(ctx)
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "reading current identity", readTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Read Current Identity",
+			err.Error(),
+		)
+		return
+	}
+
+	apiKey := graphqlResp.CurrentApiKey
+
+	if apiKey.Id == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Read Current Identity",
+			"The QueryDesk API did not return the API key the provider is configured with.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(apiKey.Id)
+	data.Name = types.StringValue(apiKey.Name)
+	data.ReadOnly = types.BoolValue(apiKey.ReadOnly)
+	data.ExpiresAt = expiresAtValue(apiKey.ExpiresAt, types.StringNull())
+
+	// An empty set rather than null, as the key can use every database.
+	if apiKey.DatabaseIds == nil {
+		apiKey.DatabaseIds = []string{}
+	}
+
+	databaseIds, diags := types.SetValueFrom(ctx, types.StringType, apiKey.DatabaseIds)
+	resp.Diagnostics.Append(diags...)
+	data.DatabaseIds = databaseIds
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
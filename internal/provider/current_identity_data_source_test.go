@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccCurrentIdentityDataSource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	mockClient.EXPECT().GetCurrentApiKey(
+		mock.Anything,
+	).Return(&client.GetCurrentApiKeyResponse{
+		CurrentApiKey: client.GetCurrentApiKeyCurrentApiKey{
+			Id:          "key_12345",
+			Name:        "ci",
+			ReadOnly:    true,
+			DatabaseIds: []string{"db_12345"},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "querydesk_current_identity" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.querydesk_current_identity.test", "id", "key_12345"),
+					resource.TestCheckResourceAttr("data.querydesk_current_identity.test", "read_only", "true"),
+					resource.TestCheckResourceAttr("data.querydesk_current_identity.test", "database_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.querydesk_current_identity.test", "database_ids.0", "db_12345"),
+					resource.TestCheckNoResourceAttr("data.querydesk_current_identity.test", "expires_at"),
+				),
+			},
+		},
+	})
+}
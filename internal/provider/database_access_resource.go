@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseAccessResource{}
+var _ resource.ResourceWithConfigure = &DatabaseAccessResource{}
+var _ resource.ResourceWithImportState = &DatabaseAccessResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseAccessResource{}
+
+func NewDatabaseAccessResource() resource.Resource {
+	return &DatabaseAccessResource{}
+}
+
+// DatabaseAccessResource grants a user or team access to a database with
+// restricted access.
+type DatabaseAccessResource struct {
+	graphqlClient client.GraphQLClient
+}
+
+// DatabaseAccessResourceModel describes the resource data model.
+type DatabaseAccessResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	DatabaseId    types.String   `tfsdk:"database_id"`
+	UserId        types.String   `tfsdk:"user_id"`
+	TeamId        types.String   `tfsdk:"team_id"`
+	CredentialIds types.Set      `tfsdk:"credential_ids"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatabaseAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_access"
+}
+
+func (r *DatabaseAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Grants a user or team access to a database with `restrict_access` set to `true`. " +
+			"Grants revoked in the QueryDesk UI are recreated on the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the database to grant access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the QueryDesk user to grant access to. Exactly one of `user_id` and `team_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the QueryDesk team to grant access to. Exactly one of `user_id` and `team_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credential_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the `querydesk_database_user` resources to limit access to. Grants access to every user of the database when not set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabaseAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.graphqlClient = data.client
+}
+
+func (r *DatabaseAccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var userId, teamId types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_id"), &userId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &teamId)...)
+
+	if resp.Diagnostics.HasError() || userId.IsUnknown() || teamId.IsUnknown() {
+		return
+	}
+
+	if userId.IsNull() == teamId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Invalid Database Access",
+			"Exactly one of `user_id` and `team_id` must be set.",
+		)
+	}
+}
+
+func (r *DatabaseAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DatabaseAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	credentialIds, diags := credentialIdsInput(ctx, data.CredentialIds)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := client.CreateDatabaseAccessInput{
+		DatabaseId:    data.DatabaseId.ValueString(),
+		UserId:        data.UserId.ValueString(),
+		TeamId:        data.TeamId.ValueString(),
+		CredentialIds: credentialIds,
+	}
+
+	graphqlResp, err := r.graphqlClient.CreateDatabaseAccess(ctx, input)
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "creating database access", createTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error creating database access", err, databaseAccessInputAttributes) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating database access",
+			"Could not create database access, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(graphqlResp.CreateDatabaseAccess.Result.Id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DatabaseAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	graphqlResp, err := r.graphqlClient.GetDatabaseAccess(ctx, data.Id.ValueString())
+
+	// Revoked in the UI, or the database was deleted
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "reading database access", readTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			err.Error(),
+		)
+		return
+	}
+
+	// If id is empty, the resource no longer exists
+	if graphqlResp.DatabaseAccess.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.DatabaseId = types.StringValue(graphqlResp.DatabaseAccess.DatabaseId)
+	data.UserId = types.StringNull()
+	if graphqlResp.DatabaseAccess.UserId != "" {
+		data.UserId = types.StringValue(graphqlResp.DatabaseAccess.UserId)
+	}
+	data.TeamId = types.StringNull()
+	if graphqlResp.DatabaseAccess.TeamId != "" {
+		data.TeamId = types.StringValue(graphqlResp.DatabaseAccess.TeamId)
+	}
+
+	// An empty list grants access to every user of the database, the same as
+	// leaving credential_ids unset.
+	if len(graphqlResp.DatabaseAccess.CredentialIds) > 0 || !data.CredentialIds.IsNull() {
+		data.CredentialIds, diags = types.SetValueFrom(ctx, types.StringType, graphqlResp.DatabaseAccess.CredentialIds)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DatabaseAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	credentialIds, diags := credentialIdsInput(ctx, data.CredentialIds)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send an empty list rather than null to widen the grant to every user.
+	if credentialIds == nil {
+		credentialIds = []string{}
+	}
+
+	_, err := r.graphqlClient.UpdateDatabaseAccess(ctx, data.Id.ValueString(), client.UpdateDatabaseAccessInput{
+		CredentialIds: credentialIds,
+	})
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "updating database access", updateTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error updating database access", err, databaseAccessInputAttributes) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error updating database access",
+			"Could not update database access, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DatabaseAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.graphqlClient.DeleteDatabaseAccess(ctx, data.Id.ValueString())
+
+	// Already revoked outside of Terraform
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "deleting database access", deleteTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete database access, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *DatabaseAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// credentialIdsInput converts credential_ids into the ids sent to QueryDesk,
+// or nil if it is not set.
+func credentialIdsInput(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	var ids []string
+	diags := set.ElementsAs(ctx, &ids, false)
+
+	return ids, diags
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccDatabaseAccessResource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const accessId = "access_12345"
+	const dbId = "db_12345"
+	const teamId = "team_12345"
+
+	mockClient.EXPECT().CreateDatabaseAccess(
+		mock.Anything,
+		client.CreateDatabaseAccessInput{
+			DatabaseId:    dbId,
+			TeamId:        teamId,
+			CredentialIds: []string{"cred_1"},
+		},
+	).Return(&client.CreateDatabaseAccessResponse{
+		CreateDatabaseAccess: client.CreateDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResult{
+			Result: client.CreateDatabaseAccessCreateDatabaseAccessCreateDatabaseAccessResultResultDatabaseAccess{
+				Id: accessId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetDatabaseAccess(
+		mock.Anything,
+		accessId,
+	).Return(&client.GetDatabaseAccessResponse{
+		DatabaseAccess: client.GetDatabaseAccessDatabaseAccess{
+			Id:            accessId,
+			DatabaseId:    dbId,
+			TeamId:        teamId,
+			CredentialIds: []string{"cred_1"},
+		},
+	}, nil).Times(3)
+
+	mockClient.EXPECT().UpdateDatabaseAccess(
+		mock.Anything,
+		accessId,
+		client.UpdateDatabaseAccessInput{
+			CredentialIds: []string{},
+		},
+	).Return(&client.UpdateDatabaseAccessResponse{
+		UpdateDatabaseAccess: client.UpdateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResult{
+			Result: client.UpdateDatabaseAccessUpdateDatabaseAccessUpdateDatabaseAccessResultResultDatabaseAccess{
+				Id: accessId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetDatabaseAccess(
+		mock.Anything,
+		accessId,
+	).Return(&client.GetDatabaseAccessResponse{
+		DatabaseAccess: client.GetDatabaseAccessDatabaseAccess{
+			Id:         accessId,
+			DatabaseId: dbId,
+			TeamId:     teamId,
+		},
+	}, nil).Once()
+
+	// Revoked in the UI
+	mockClient.EXPECT().GetDatabaseAccess(
+		mock.Anything,
+		accessId,
+	).Return(nil, fmt.Errorf("%w (request id: 1)", client.ErrNotFound)).Once()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "querydesk_database_access" "test" {
+  database_id = "db_12345"
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of `user_id` and `team_id` must be set"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDatabaseAccessResourceConfig(`["cred_1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_database_access.test", "id", accessId),
+					resource.TestCheckResourceAttr("querydesk_database_access.test", "team_id", teamId),
+					resource.TestCheckNoResourceAttr("querydesk_database_access.test", "user_id"),
+					resource.TestCheckTypeSetElemAttr("querydesk_database_access.test", "credential_ids.*", "cred_1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "querydesk_database_access.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseAccessResourceConfig("null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("querydesk_database_access.test", "credential_ids"),
+				),
+			},
+			// Revoked grants are planned to be created again
+			{
+				Config:             testAccDatabaseAccessResourceConfig("null"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDatabaseAccessResourceConfig(credentialIds string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "querydesk_database_access" "test" {
+  database_id    = "db_12345"
+  team_id        = "team_12345"
+  credential_ids = %s
+}
+`, credentialIds)
+}
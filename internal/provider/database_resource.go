@@ -5,15 +5,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"slices"
 	"terraform-provider-querydesk/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +28,8 @@ import (
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithConfigure = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseResource{}
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
@@ -28,21 +37,30 @@ func NewDatabaseResource() resource.Resource {
 
 // DatabaseResource defines the resource implementation.
 type DatabaseResource struct {
-	graphqlClient client.GraphQLClient
+	graphqlClient  client.GraphQLClient
+	duplicateCheck string
 }
 
 // DatabaseResourceModel describes the resource data model.
 type DatabaseResourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Adapter        types.String `tfsdk:"adapter"`
-	Hostname       types.String `tfsdk:"hostname"`
-	Database       types.String `tfsdk:"database"`
-	Ssl            types.Bool   `tfsdk:"ssl"`
-	CaCertFile     types.String `tfsdk:"cacertfile"`
-	KeyFile        types.String `tfsdk:"keyfile"`
-	CertFile       types.String `tfsdk:"certfile"`
-	RestrictAccess types.Bool   `tfsdk:"restrict_access"`
+	Id                 types.String            `tfsdk:"id"`
+	Name               types.String            `tfsdk:"name"`
+	Adapter            types.String            `tfsdk:"adapter"`
+	Hostname           types.String            `tfsdk:"hostname"`
+	Port               types.Int64             `tfsdk:"port"`
+	Database           types.String            `tfsdk:"database"`
+	Ssl                types.Bool              `tfsdk:"ssl"`
+	CaCertFile         types.String            `tfsdk:"cacertfile"`
+	KeyFile            types.String            `tfsdk:"keyfile"`
+	CertFile           types.String            `tfsdk:"certfile"`
+	RestrictAccess     types.Bool              `tfsdk:"restrict_access"`
+	DeletionProtection types.Bool              `tfsdk:"deletion_protection"`
+	ForceDelete        types.Bool              `tfsdk:"force_delete"`
+	UpdatedAt          types.String            `tfsdk:"updated_at"`
+	Postgres           *DatabasePostgresModel  `tfsdk:"postgres"`
+	Mysql              *DatabaseMysqlModel     `tfsdk:"mysql"`
+	Sqlserver          *DatabaseSqlserverModel `tfsdk:"sqlserver"`
+	Timeouts           timeouts.Value          `tfsdk:"timeouts"`
 }
 
 func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +69,10 @@ func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Bump the version and add a StateUpgrader whenever existing state
+		// would no longer be valid for this schema.
+		Version: 2,
+
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Database resource",
 
@@ -67,16 +89,34 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required:            true,
 			},
 			"adapter": schema.StringAttribute{
-				MarkdownDescription: "The adapter to use to establish the connection. Currently only `POSTGRES` and `MYSQL` are supported, but  sql server is on the roadmap.",
+				MarkdownDescription: "The adapter to use to establish the connection, one of `POSTGRES`, `MYSQL` or `SQLSERVER`.",
 				Required:            true,
 			},
 			"database": schema.StringAttribute{
-				MarkdownDescription: "The name of the database to connect to.",
-				Required:            true,
+				MarkdownDescription: "The name of the database to connect to. Must fit the limits of the adapter, " +
+					"such as at most 63 bytes for `POSTGRES` and 64 characters without `/`, `\\` or `.` for `MYSQL`.",
+				Required: true,
+				Validators: []validator.String{
+					databaseNameValidator{},
+				},
 			},
 			"hostname": schema.StringAttribute{
-				MarkdownDescription: "The hostname for connecting to the database, either an ip or url.",
+				MarkdownDescription: "The hostname for connecting to the database, either a DNS name, an IPv4 address or a bracketed IPv6 address such as `[::1]`. Must not include a scheme, port or path.",
 				Required:            true,
+				Validators: []validator.String{
+					hostnameValidator{},
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port for connecting to the database. Defaults to `5432` for `POSTGRES`, `3306` for `MYSQL` and `1433` for `SQLSERVER`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					portValidator{},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					defaultPortModifier{},
+				},
 			},
 			"ssl": schema.BoolAttribute{
 				MarkdownDescription: "Set to `true` to turn on ssl connections for this database.",
@@ -105,6 +145,84 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the database, including when a change replaces it. " +
+					"Set it to `false` and apply before destroying the database. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"force_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the database also deletes its remaining users, which QueryDesk otherwise refuses. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the database was last changed in QueryDesk. Applying fails, rather than overwriting the changes, " +
+					"if the database was changed outside of Terraform after the plan was made.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"postgres": schema.SingleNestedBlock{
+				MarkdownDescription: "Connection options for `POSTGRES` databases. Conflicts with `mysql` and `sqlserver`.",
+				Attributes: map[string]schema.Attribute{
+					"sslmode": schema.StringAttribute{
+						MarkdownDescription: "The libpq sslmode, one of `disable`, `allow`, `prefer`, `require`, `verify-ca` or `verify-full`. " +
+							"Takes precedence over `ssl`, which must be `true` for `require`, `verify-ca` and `verify-full`.",
+						Optional: true,
+						Validators: []validator.String{
+							postgresSslmodeValidator{},
+						},
+					},
+					"search_path": schema.StringAttribute{
+						MarkdownDescription: "A comma separated list of schemas to set as the `search_path`, such as `app, public`.",
+						Optional:            true,
+						Validators: []validator.String{
+							searchPathValidator{},
+						},
+					},
+				},
+			},
+			"mysql": schema.SingleNestedBlock{
+				MarkdownDescription: "Connection options for `MYSQL` databases. Conflicts with `postgres` and `sqlserver`.",
+				Attributes: map[string]schema.Attribute{
+					"charset": schema.StringAttribute{
+						MarkdownDescription: "The character set for the connection, such as `utf8mb4`.",
+						Optional:            true,
+						Validators: []validator.String{
+							mysqlNameValidator{},
+						},
+					},
+					"collation": schema.StringAttribute{
+						MarkdownDescription: "The collation for the connection, such as `utf8mb4_unicode_ci`. Must belong to `charset` if both are set.",
+						Optional:            true,
+						Validators: []validator.String{
+							mysqlNameValidator{collation: true},
+						},
+					},
+				},
+			},
+			"sqlserver": schema.SingleNestedBlock{
+				MarkdownDescription: "Connection options for `SQLSERVER` databases. Conflicts with `postgres` and `mysql`.",
+				Attributes: map[string]schema.Attribute{
+					"trust_server_certificate": schema.BoolAttribute{
+						MarkdownDescription: "Whether to skip validating the server certificate when connecting with `ssl`, " +
+							"e.g. for a self-signed certificate. Requires `ssl` to be `true`. Defaults to `false`.",
+						Optional: true,
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -115,18 +233,54 @@ func (r *DatabaseResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	graphqlClient, ok := req.ProviderData.(client.GraphQLClient)
+	data, ok := req.ProviderData.(providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.GraphQLReq, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.graphqlClient = graphqlClient
+	r.graphqlClient = data.client
+	r.duplicateCheck = data.duplicateCheck
+}
+
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planDatabaseUpdatedAt(ctx, req, resp)
+
+	// Nothing to check before the provider is configured.
+	if r.graphqlClient == nil {
+		return
+	}
+
+	var id, name, priorName types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &priorName)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only new names are checked, so that databases already sharing a name
+	// can still be changed.
+	if name.Equal(priorName) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDuplicateDatabaseName(ctx, r.graphqlClient, r.duplicateCheck, id, name)...)
 }
 
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -139,48 +293,64 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var adapter client.DatabaseAdapter
 	switch data.Adapter.ValueString() {
 	case "POSTGRES":
 		adapter = client.DatabaseAdapterPostgres
 	case "MYSQL":
 		adapter = client.DatabaseAdapterMysql
+	case "SQLSERVER":
+		adapter = client.DatabaseAdapterSqlserver
 	default:
-		resp.Diagnostics.AddError("Unexpected Database Adapter", fmt.Sprintf("Expected `POSTGRES` or `MYSQL`, got: %s.", data.Adapter.String()))
+		resp.Diagnostics.AddError("Unexpected Database Adapter", fmt.Sprintf("Expected `POSTGRES`, `MYSQL` or `SQLSERVER`, got: %s.", data.Adapter.String()))
 		return
 	}
 
 	input := client.CreateDatabaseInput{
-		Name:           data.Name.ValueString(),
-		Adapter:        adapter,
-		Hostname:       data.Hostname.ValueString(),
-		Database:       data.Database.ValueString(),
-		Ssl:            data.Ssl.ValueBool(),
-		Cacertfile:     data.CaCertFile.ValueString(),
-		Keyfile:        data.KeyFile.ValueString(),
-		Certfile:       data.CertFile.ValueString(),
-		RestrictAccess: data.RestrictAccess.ValueBool(),
+		Name:             data.Name.ValueString(),
+		Adapter:          adapter,
+		Hostname:         joinHostname(data.Hostname.ValueString(), data.Port.ValueInt64(), data.Adapter.ValueString()),
+		Database:         data.Database.ValueString(),
+		Ssl:              data.Ssl.ValueBool(),
+		Cacertfile:       data.CaCertFile.ValueString(),
+		Keyfile:          data.KeyFile.ValueString(),
+		Certfile:         data.CertFile.ValueString(),
+		RestrictAccess:   data.RestrictAccess.ValueBool(),
+		PostgresOptions:  postgresOptionsInput(data.Postgres),
+		MysqlOptions:     mysqlOptionsInput(data.Mysql),
+		SqlserverOptions: sqlserverOptionsInput(data.Sqlserver),
 	}
 
 	graphqlResp, err := r.graphqlClient.CreateDatabase(ctx, input)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating database",
-			"Could not create database, unexpected error: "+err.Error(),
-		)
-		return
-	}
+		if addTimeoutError(&resp.Diagnostics, err, "creating database", createTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error creating database", err, databaseInputAttributes) {
+			return
+		}
 
-	if len(graphqlResp.CreateDatabase.Errors) > 0 {
 		resp.Diagnostics.AddError(
 			"Error creating database",
-			"Could not create database: "+graphqlResp.CreateDatabase.Errors[0].Message,
+			"Could not create database, unexpected error: "+err.Error(),
 		)
 		return
 	}
 
 	data.Id = types.StringValue(graphqlResp.CreateDatabase.Result.Id)
+	data.UpdatedAt = types.StringValue(graphqlResp.CreateDatabase.Result.UpdatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -196,9 +366,28 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	graphqlResp, err := r.graphqlClient.GetDatabase(ctx, data.Id.ValueString())
 
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "reading database", readTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Unable to Refresh Resource",
 			err.Error(),
@@ -214,51 +403,91 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	data.Name = types.StringValue(graphqlResp.Database.Name)
 	data.Adapter = types.StringValue(string(graphqlResp.Database.Adapter))
-	data.Hostname = types.StringValue(graphqlResp.Database.Hostname)
+	hostname, port := splitHostname(graphqlResp.Database.Hostname, string(graphqlResp.Database.Adapter))
+	data.Hostname = types.StringValue(hostname)
+	data.Port = types.Int64Value(port)
 	data.Database = types.StringValue(graphqlResp.Database.Database)
 	data.Ssl = types.BoolValue(graphqlResp.Database.Ssl)
 	data.RestrictAccess = types.BoolValue(graphqlResp.Database.RestrictAccess)
+	data.Postgres = postgresOptionsModel(graphqlResp.Database.PostgresOptions.Sslmode, graphqlResp.Database.PostgresOptions.SearchPath, data.Postgres)
+	data.Mysql = mysqlOptionsModel(graphqlResp.Database.MysqlOptions.Charset, graphqlResp.Database.MysqlOptions.Collation, data.Mysql)
+	data.Sqlserver = sqlserverOptionsModel(graphqlResp.Database.SqlserverOptions.TrustServerCertificate, data.Sqlserver)
+	data.UpdatedAt = types.StringValue(graphqlResp.Database.UpdatedAt)
+
+	// Only known to Terraform, imported databases get the defaults.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
+	if data.ForceDelete.IsNull() {
+		data.ForceDelete = types.BoolValue(false)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *DatabaseResourceModel
+	var data, state *DatabaseResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var adapter client.DatabaseAdapter
 	switch data.Adapter.ValueString() {
 	case "POSTGRES":
 		adapter = client.DatabaseAdapterPostgres
 	case "MYSQL":
 		adapter = client.DatabaseAdapterMysql
+	case "SQLSERVER":
+		adapter = client.DatabaseAdapterSqlserver
 	default:
-		resp.Diagnostics.AddError("Unexpected Database Adapter", fmt.Sprintf("Expected `POSTGRES` or `MYSQL`, got: %s.", data.Adapter.String()))
+		resp.Diagnostics.AddError("Unexpected Database Adapter", fmt.Sprintf("Expected `POSTGRES`, `MYSQL` or `SQLSERVER`, got: %s.", data.Adapter.String()))
 		return
 	}
 
-	input := client.UpdateDatabaseInput{
-		Name:           data.Name.ValueString(),
-		Adapter:        adapter,
-		Hostname:       data.Hostname.ValueString(),
-		Database:       data.Database.ValueString(),
-		Ssl:            data.Ssl.ValueBool(),
-		NewCacertfile:  data.CaCertFile.ValueString(),
-		NewKeyfile:     data.KeyFile.ValueString(),
-		NewCertfile:    data.CertFile.ValueString(),
-		RestrictAccess: data.RestrictAccess.ValueBool(),
+	input := databaseUpdateInput(data, state, adapter)
+
+	// Nothing to send when only attributes kept in Terraform, such as
+	// deletion_protection, changed.
+	if reflect.DeepEqual(input, client.UpdateDatabaseInput{}) {
+		data.UpdatedAt = state.UpdatedAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(checkDatabaseUnchanged(ctx, r.graphqlClient, data.Id.ValueString(), state.UpdatedAt)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	graphqlResp, err := r.graphqlClient.UpdateDatabase(ctx, data.Id.ValueString(), input)
 
 	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "updating database", updateTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error updating database", err, databaseInputAttributes) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error updating database",
 			"Could not update database, unexpected error: "+err.Error(),
@@ -267,13 +496,7 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	if len(graphqlResp.UpdateDatabase.Errors) > 0 {
-		resp.Diagnostics.AddError(
-			"Error updating database",
-			"Could not update database, unexpected error: "+graphqlResp.UpdateDatabase.Errors[0].Message,
-		)
-		return
-	}
+	data.UpdatedAt = types.StringValue(graphqlResp.UpdateDatabase.Result.UpdatedAt)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -289,26 +512,207 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	graphqlResp, err := r.graphqlClient.DeleteDatabase(ctx, data.Id.ValueString())
-
-	if err != nil {
+	if data.DeletionProtection.ValueBool() {
 		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to delete database, got error: %s", err),
+			"Database Deletion Protected",
+			fmt.Sprintf("Cannot destroy database %s while deletion_protection is true. "+
+				"If the database should be destroyed or replaced, set deletion_protection to false and apply first.", data.Name.String()),
 		)
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if len(graphqlResp.DeleteDatabase.Errors) > 0 {
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(checkDatabaseUnchanged(ctx, r.graphqlClient, data.Id.ValueString(), data.UpdatedAt)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attempt := 1; ; attempt++ {
+		_, err := r.graphqlClient.DeleteDatabase(ctx, data.Id.ValueString())
+
+		// Already deleted outside of Terraform
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+
+		if err == nil {
+			return
+		}
+
+		if addTimeoutError(&resp.Diagnostics, err, "deleting database", deleteTimeout) {
+			return
+		}
+
+		// QueryDesk refuses to delete a database that still has users, so
+		// delete them and try once more.
+		if databaseHasCredentials(err) {
+			if attempt == 1 && data.ForceDelete.ValueBool() {
+				deleted := r.deleteCredentials(ctx, data.Id.ValueString(), deleteTimeout, &resp.Diagnostics)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if deleted > 0 {
+					continue
+				}
+			}
+
+			detail := "Could not delete database: " + err.Error()
+			if !data.ForceDelete.ValueBool() {
+				detail += "\n\nSet force_delete to true and apply to delete its users with it."
+			}
+
+			resp.Diagnostics.AddError("Error deleting database", detail)
+			return
+		}
+
 		resp.Diagnostics.AddError(
-			"Error deleting database",
-			"Could not delete database, unexpected error: "+graphqlResp.DeleteDatabase.Errors[0].Message,
+			"Client Error",
+			fmt.Sprintf("Unable to delete database, got error: %s", err),
 		)
 		return
 	}
 }
 
+// databaseHasCredentials reports whether err is QueryDesk refusing to delete
+// a database because users were still created on it. Only the structured
+// fields are trusted, as force_delete deletes every user of the database.
+func databaseHasCredentials(err error) bool {
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+
+	return slices.Contains(validationErr.Fields, "credentials")
+}
+
+// databaseUpdateInput returns the fields of plan that differ from state, so
+// that edits made to other fields in the UI are kept.
+func databaseUpdateInput(plan *DatabaseResourceModel, state *DatabaseResourceModel, adapter client.DatabaseAdapter) client.UpdateDatabaseInput {
+	var input client.UpdateDatabaseInput
+
+	if !plan.Name.Equal(state.Name) {
+		input.Name = plan.Name.ValueStringPointer()
+	}
+
+	if !plan.Adapter.Equal(state.Adapter) {
+		input.Adapter = &adapter
+	}
+
+	// The port is part of the hostname, unless it is the default port of the
+	// adapter.
+	if !plan.Hostname.Equal(state.Hostname) || !plan.Port.Equal(state.Port) || !plan.Adapter.Equal(state.Adapter) {
+		hostname := joinHostname(plan.Hostname.ValueString(), plan.Port.ValueInt64(), plan.Adapter.ValueString())
+		input.Hostname = &hostname
+	}
+
+	if !plan.Database.Equal(state.Database) {
+		input.Database = plan.Database.ValueStringPointer()
+	}
+
+	if !plan.Ssl.Equal(state.Ssl) {
+		input.Ssl = plan.Ssl.ValueBoolPointer()
+	}
+
+	if !plan.RestrictAccess.Equal(state.RestrictAccess) {
+		input.RestrictAccess = plan.RestrictAccess.ValueBoolPointer()
+	}
+
+	// Removed files are sent as empty strings.
+	if !plan.CaCertFile.Equal(state.CaCertFile) {
+		caCertFile := plan.CaCertFile.ValueString()
+		input.NewCacertfile = &caCertFile
+	}
+
+	if !plan.KeyFile.Equal(state.KeyFile) {
+		keyFile := plan.KeyFile.ValueString()
+		input.NewKeyfile = &keyFile
+	}
+
+	if !plan.CertFile.Equal(state.CertFile) {
+		certFile := plan.CertFile.ValueString()
+		input.NewCertfile = &certFile
+	}
+
+	if postgres := postgresOptionsInput(plan.Postgres); !reflect.DeepEqual(postgres, postgresOptionsInput(state.Postgres)) {
+		input.PostgresOptions = client.Nullable(postgres)
+	}
+
+	if mysql := mysqlOptionsInput(plan.Mysql); !reflect.DeepEqual(mysql, mysqlOptionsInput(state.Mysql)) {
+		input.MysqlOptions = client.Nullable(mysql)
+	}
+
+	if sqlserver := sqlserverOptionsInput(plan.Sqlserver); !reflect.DeepEqual(sqlserver, sqlserverOptionsInput(state.Sqlserver)) {
+		input.SqlserverOptions = client.Nullable(sqlserver)
+	}
+
+	return input
+}
+
+// deleteCredentials deletes the users left on a database, returning how many
+// were deleted.
+func (r *DatabaseResource) deleteCredentials(ctx context.Context, databaseId string, deleteTimeout time.Duration, diags *diag.Diagnostics) int {
+	graphqlResp, err := r.graphqlClient.GetDatabaseCredentials(ctx, databaseId)
+
+	if errors.Is(err, client.ErrNotFound) {
+		return 0
+	}
+
+	if err != nil {
+		if addTimeoutError(diags, err, "listing database users", deleteTimeout) {
+			return 0
+		}
+
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list the users of the database, got error: %s", err),
+		)
+		return 0
+	}
+
+	deleted := 0
+
+	for _, credential := range graphqlResp.Database.Credentials {
+		_, err := r.graphqlClient.DeleteCredential(ctx, credential.Id)
+
+		// Deleted since they were listed
+		if errors.Is(err, client.ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			if addTimeoutError(diags, err, "deleting database user", deleteTimeout) {
+				return deleted
+			}
+
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to delete database user %s, got error: %s", credential.Id, err),
+			)
+			return deleted
+		}
+
+		deleted++
+	}
+
+	return deleted
+}
+
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateConnectionOptions(ctx, req.Config)...)
+}
+
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
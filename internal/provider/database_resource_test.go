@@ -4,11 +4,15 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"terraform-provider-querydesk/internal/client"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -16,6 +20,18 @@ func TestAccDatabaseResource(t *testing.T) {
 	mockClient := client.NewMockGraphQLClient(t)
 
 	const dbId = "db_12345"
+	const createdAt = "2024-07-01T09:00:00Z"
+	const updatedAt = "2024-07-02T09:00:00Z"
+
+	mockClient.EXPECT().FindDatabasesByName(
+		mock.Anything,
+		"one",
+	).Return(&client.FindDatabasesByNameResponse{}, nil)
+
+	mockClient.EXPECT().FindDatabasesByName(
+		mock.Anything,
+		"two",
+	).Return(&client.FindDatabasesByNameResponse{}, nil)
 
 	mockClient.EXPECT().CreateDatabase(
 		mock.Anything,
@@ -34,7 +50,8 @@ func TestAccDatabaseResource(t *testing.T) {
 	).Return(&client.CreateDatabaseResponse{
 		CreateDatabase: client.CreateDatabaseCreateDatabaseCreateDatabaseResult{
 			Result: client.CreateDatabaseCreateDatabaseCreateDatabaseResultResultDatabase{
-				Id: dbId,
+				Id:        dbId,
+				UpdatedAt: createdAt,
 			},
 			Errors: nil,
 		},
@@ -52,28 +69,21 @@ func TestAccDatabaseResource(t *testing.T) {
 			Database:       "mydb",
 			Ssl:            false,
 			RestrictAccess: true,
+			UpdatedAt:      createdAt,
 		},
-	}, nil).Times(3)
+	}, nil).Times(4)
 
 	mockClient.EXPECT().UpdateDatabase(
 		mock.Anything,
 		dbId,
 		client.UpdateDatabaseInput{
-			Name:           "two",
-			Adapter:        client.DatabaseAdapterPostgres,
-			Hostname:       "localhost",
-			Database:       "mydb",
-			Ssl:            false,
-			RestrictAccess: true,
-			NewCacertfile:  "",
-			NewKeyfile:     "",
-			NewCertfile:    "",
-			AgentId:        "",
+			Name: ptr("two"),
 		},
 	).Return(&client.UpdateDatabaseResponse{
 		UpdateDatabase: client.UpdateDatabaseUpdateDatabaseUpdateDatabaseResult{
 			Result: client.UpdateDatabaseUpdateDatabaseUpdateDatabaseResultResultDatabase{
-				Id: dbId,
+				Id:        dbId,
+				UpdatedAt: updatedAt,
 			},
 			Errors: nil,
 		},
@@ -91,6 +101,7 @@ func TestAccDatabaseResource(t *testing.T) {
 			Database:       "mydb",
 			Ssl:            false,
 			RestrictAccess: true,
+			UpdatedAt:      updatedAt,
 		},
 	}, nil)
 
@@ -116,8 +127,10 @@ func TestAccDatabaseResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("querydesk_database.test", "id", dbId),
 					resource.TestCheckResourceAttr("querydesk_database.test", "name", "one"),
+					resource.TestCheckResourceAttr("querydesk_database.test", "port", "5432"),
 					resource.TestCheckResourceAttr("querydesk_database.test", "ssl", "false"),
 					resource.TestCheckResourceAttr("querydesk_database.test", "restrict_access", "true"),
+					resource.TestCheckResourceAttr("querydesk_database.test", "updated_at", createdAt),
 				),
 			},
 			// ImportState testing
@@ -131,6 +144,7 @@ func TestAccDatabaseResource(t *testing.T) {
 				Config: testAccDatabaseResourceConfig("two"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("querydesk_database.test", "name", "two"),
+					resource.TestCheckResourceAttr("querydesk_database.test", "updated_at", updatedAt),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -148,3 +162,470 @@ resource "querydesk_database" "test" {
 }
 `, name)
 }
+
+func TestAccDatabaseResource_SqlServer(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const dbId = "db_67890"
+
+	mockClient.EXPECT().FindDatabasesByName(
+		mock.Anything,
+		"reporting",
+	).Return(&client.FindDatabasesByNameResponse{}, nil)
+
+	mockClient.EXPECT().CreateDatabase(
+		mock.Anything,
+		client.CreateDatabaseInput{
+			Name:           "reporting",
+			Adapter:        client.DatabaseAdapterSqlserver,
+			Hostname:       "sql.internal",
+			Database:       "reports",
+			Ssl:            true,
+			RestrictAccess: true,
+		},
+	).Return(&client.CreateDatabaseResponse{
+		CreateDatabase: client.CreateDatabaseCreateDatabaseCreateDatabaseResult{
+			Result: client.CreateDatabaseCreateDatabaseCreateDatabaseResultResultDatabase{
+				Id: dbId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetDatabase(
+		mock.Anything,
+		dbId,
+	).Return(&client.GetDatabaseResponse{
+		Database: client.GetDatabaseDatabase{
+			Id:             dbId,
+			Name:           "reporting",
+			Adapter:        client.DatabaseAdapterSqlserver,
+			Hostname:       "sql.internal",
+			Database:       "reports",
+			Ssl:            true,
+			RestrictAccess: true,
+		},
+	}, nil)
+
+	mockClient.EXPECT().DeleteDatabase(
+		mock.Anything,
+		dbId,
+	).Return(&client.DeleteDatabaseResponse{
+		DeleteDatabase: client.DeleteDatabaseDeleteDatabaseDeleteDatabaseResult{
+			Result: client.DeleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase{
+				Id: dbId,
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "querydesk_database" "test" {
+  name     = "reporting"
+  adapter  = "SQLSERVER"
+  hostname = "sql.internal"
+  database = "reports"
+  ssl      = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_database.test", "id", dbId),
+					resource.TestCheckResourceAttr("querydesk_database.test", "adapter", "SQLSERVER"),
+					resource.TestCheckResourceAttr("querydesk_database.test", "port", "1433"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_PostgresOptions(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const dbId = "db_24680"
+
+	mockClient.EXPECT().FindDatabasesByName(
+		mock.Anything,
+		"billing",
+	).Return(&client.FindDatabasesByNameResponse{}, nil)
+
+	mockClient.EXPECT().CreateDatabase(
+		mock.Anything,
+		client.CreateDatabaseInput{
+			Name:           "billing",
+			Adapter:        client.DatabaseAdapterPostgres,
+			Hostname:       "db.internal",
+			Database:       "billing",
+			Ssl:            true,
+			RestrictAccess: true,
+			PostgresOptions: &client.DatabasePostgresOptionsInput{
+				Sslmode:    client.DatabasePostgresSslmodeVerifyFull,
+				SearchPath: "billing, public",
+			},
+		},
+	).Return(&client.CreateDatabaseResponse{
+		CreateDatabase: client.CreateDatabaseCreateDatabaseCreateDatabaseResult{
+			Result: client.CreateDatabaseCreateDatabaseCreateDatabaseResultResultDatabase{
+				Id: dbId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetDatabase(
+		mock.Anything,
+		dbId,
+	).Return(&client.GetDatabaseResponse{
+		Database: client.GetDatabaseDatabase{
+			Id:             dbId,
+			Name:           "billing",
+			Adapter:        client.DatabaseAdapterPostgres,
+			Hostname:       "db.internal",
+			Database:       "billing",
+			Ssl:            true,
+			RestrictAccess: true,
+			PostgresOptions: client.GetDatabaseDatabasePostgresOptions{
+				Sslmode:    client.DatabasePostgresSslmodeVerifyFull,
+				SearchPath: "billing, public",
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().DeleteDatabase(
+		mock.Anything,
+		dbId,
+	).Return(&client.DeleteDatabaseResponse{
+		DeleteDatabase: client.DeleteDatabaseDeleteDatabaseDeleteDatabaseResult{
+			Result: client.DeleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase{
+				Id: dbId,
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "querydesk_database" "test" {
+  name     = "billing"
+  adapter  = "MYSQL"
+  hostname = "db.internal"
+  database = "billing"
+
+  postgres {
+    sslmode = "prefer"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("can only be used with adapter `POSTGRES`"),
+			},
+			{
+				Config: providerConfig + `
+resource "querydesk_database" "test" {
+  name     = "billing"
+  adapter  = "POSTGRES"
+  hostname = "db.internal"
+  database = "billing"
+  ssl      = true
+
+  postgres {
+    sslmode     = "verify-full"
+    search_path = "billing, public"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_database.test", "id", dbId),
+					resource.TestCheckResourceAttr("querydesk_database.test", "postgres.sslmode", "verify-full"),
+					resource.TestCheckResourceAttr("querydesk_database.test", "postgres.search_path", "billing, public"),
+					resource.TestCheckNoResourceAttr("querydesk_database.test", "mysql.charset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_DeletionProtection(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const dbId = "db_24680"
+
+	mockClient.EXPECT().FindDatabasesByName(
+		mock.Anything,
+		"payments",
+	).Return(&client.FindDatabasesByNameResponse{}, nil)
+
+	mockClient.EXPECT().CreateDatabase(
+		mock.Anything,
+		client.CreateDatabaseInput{
+			Name:           "payments",
+			Adapter:        client.DatabaseAdapterPostgres,
+			Hostname:       "db.internal",
+			Database:       "payments",
+			RestrictAccess: true,
+		},
+	).Return(&client.CreateDatabaseResponse{
+		CreateDatabase: client.CreateDatabaseCreateDatabaseCreateDatabaseResult{
+			Result: client.CreateDatabaseCreateDatabaseCreateDatabaseResultResultDatabase{
+				Id: dbId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetDatabase(
+		mock.Anything,
+		dbId,
+	).Return(&client.GetDatabaseResponse{
+		Database: client.GetDatabaseDatabase{
+			Id:             dbId,
+			Name:           "payments",
+			Adapter:        client.DatabaseAdapterPostgres,
+			Hostname:       "db.internal",
+			Database:       "payments",
+			RestrictAccess: true,
+		},
+	}, nil)
+
+	// Refused while the database has users, then deleted once they are gone.
+	mockClient.EXPECT().DeleteDatabase(
+		mock.Anything,
+		dbId,
+	).Return(nil, fmt.Errorf("%w (request id: 1)", &client.ValidationError{
+		Message: "database still has credentials",
+		Code:    "invalid_attribute",
+		Fields:  []string{"credentials"},
+	})).Once()
+
+	mockClient.EXPECT().GetDatabaseCredentials(
+		mock.Anything,
+		dbId,
+	).Return(&client.GetDatabaseCredentialsResponse{
+		Database: client.GetDatabaseCredentialsDatabase{
+			Id: dbId,
+			Credentials: []client.GetDatabaseCredentialsDatabaseCredentialsCredential{
+				{Id: "cred_1"},
+				{Id: "cred_2"},
+			},
+		},
+	}, nil)
+
+	for _, credentialId := range []string{"cred_1", "cred_2"} {
+		mockClient.EXPECT().DeleteCredential(
+			mock.Anything,
+			credentialId,
+		).Return(&client.DeleteCredentialResponse{
+			DeleteCredential: client.DeleteCredentialDeleteCredentialDeleteCredentialResult{
+				Result: client.DeleteCredentialDeleteCredentialDeleteCredentialResultResultCredential{
+					Id: credentialId,
+				},
+			},
+		}, nil)
+	}
+
+	mockClient.EXPECT().DeleteDatabase(
+		mock.Anything,
+		dbId,
+	).Return(&client.DeleteDatabaseResponse{
+		DeleteDatabase: client.DeleteDatabaseDeleteDatabaseDeleteDatabaseResult{
+			Result: client.DeleteDatabaseDeleteDatabaseDeleteDatabaseResultResultDatabase{
+				Id: dbId,
+			},
+		},
+	}, nil).Once()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceDeletionProtectionConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_database.test", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("querydesk_database.test", "force_delete", "true"),
+				),
+			},
+			{
+				Config:      testAccDatabaseResourceDeletionProtectionConfig(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Database Deletion Protected"),
+			},
+			// Only kept in Terraform, so nothing is sent to QueryDesk
+			{
+				Config: testAccDatabaseResourceDeletionProtectionConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_database.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDatabaseHasCredentials(t *testing.T) {
+	assert.True(t, databaseHasCredentials(fmt.Errorf("%w (request id: 1)", &client.ValidationError{Message: "is invalid", Fields: []string{"credentials"}})))
+	assert.False(t, databaseHasCredentials(&client.ValidationError{Message: "database still has credentials"}))
+	assert.False(t, databaseHasCredentials(&client.ValidationError{Message: "is invalid", Fields: []string{"name"}}))
+	assert.False(t, databaseHasCredentials(fmt.Errorf("%w: credentials", client.ErrServer)))
+}
+
+func testAccDatabaseResourceDeletionProtectionConfig(deletionProtection bool) string {
+	return providerConfig + fmt.Sprintf(`
+resource "querydesk_database" "test" {
+  name     = "payments"
+  adapter  = "POSTGRES"
+  hostname = "db.internal"
+  database = "payments"
+
+  deletion_protection = %t
+  force_delete        = true
+}
+`, deletionProtection)
+}
+
+func TestAccDatabaseResource_DuplicateName(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	mockClient.EXPECT().FindDatabasesByName(
+		mock.Anything,
+		"payments",
+	).Return(&client.FindDatabasesByNameResponse{
+		ListDatabases: []client.FindDatabasesByNameListDatabasesDatabase{
+			{Id: "db_13579"},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseResourceDeletionProtectionConfig(false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Database Name"),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_DatabaseName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(client.NewMockGraphQLClient(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "querydesk_database" "test" {
+  name     = "billing"
+  adapter  = "MYSQL"
+  hostname = "db.internal"
+  database = "billing.v2"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Database Name"),
+			},
+		},
+	})
+}
+
+func TestDatabaseUpdateInput(t *testing.T) {
+	state := func() *DatabaseResourceModel {
+		return &DatabaseResourceModel{
+			Name:           types.StringValue("payments"),
+			Adapter:        types.StringValue("POSTGRES"),
+			Hostname:       types.StringValue("db.internal"),
+			Port:           types.Int64Value(5432),
+			Database:       types.StringValue("payments"),
+			Ssl:            types.BoolValue(true),
+			CaCertFile:     types.StringValue("ca"),
+			KeyFile:        types.StringNull(),
+			CertFile:       types.StringNull(),
+			RestrictAccess: types.BoolValue(false),
+			Postgres: &DatabasePostgresModel{
+				Sslmode:    types.StringValue("require"),
+				SearchPath: types.StringValue("public"),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		change func(plan *DatabaseResourceModel)
+		want   client.UpdateDatabaseInput
+	}{
+		"unchanged": {
+			change: func(plan *DatabaseResourceModel) {},
+			want:   client.UpdateDatabaseInput{},
+		},
+		"name": {
+			change: func(plan *DatabaseResourceModel) { plan.Name = types.StringValue("billing") },
+			want:   client.UpdateDatabaseInput{Name: ptr("billing")},
+		},
+		"adapter": {
+			change: func(plan *DatabaseResourceModel) {
+				plan.Adapter = types.StringValue("MYSQL")
+				plan.Postgres = nil
+			},
+			want: client.UpdateDatabaseInput{
+				Adapter:         ptr(client.DatabaseAdapterMysql),
+				Hostname:        ptr("db.internal:5432"),
+				PostgresOptions: json.RawMessage("null"),
+			},
+		},
+		"port": {
+			change: func(plan *DatabaseResourceModel) { plan.Port = types.Int64Value(6432) },
+			want:   client.UpdateDatabaseInput{Hostname: ptr("db.internal:6432")},
+		},
+		"ssl": {
+			change: func(plan *DatabaseResourceModel) { plan.Ssl = types.BoolValue(false) },
+			want:   client.UpdateDatabaseInput{Ssl: ptr(false)},
+		},
+		"restrict_access": {
+			change: func(plan *DatabaseResourceModel) { plan.RestrictAccess = types.BoolValue(true) },
+			want:   client.UpdateDatabaseInput{RestrictAccess: ptr(true)},
+		},
+		"cacertfile removed": {
+			change: func(plan *DatabaseResourceModel) { plan.CaCertFile = types.StringNull() },
+			want:   client.UpdateDatabaseInput{NewCacertfile: ptr("")},
+		},
+		"keyfile added": {
+			change: func(plan *DatabaseResourceModel) { plan.KeyFile = types.StringValue("key") },
+			want:   client.UpdateDatabaseInput{NewKeyfile: ptr("key")},
+		},
+		"postgres option": {
+			change: func(plan *DatabaseResourceModel) { plan.Postgres.SearchPath = types.StringValue("billing") },
+			want: client.UpdateDatabaseInput{
+				PostgresOptions: json.RawMessage(`{"sslmode":"REQUIRE","searchPath":"billing"}`),
+			},
+		},
+		"postgres removed": {
+			change: func(plan *DatabaseResourceModel) { plan.Postgres = nil },
+			want:   client.UpdateDatabaseInput{PostgresOptions: json.RawMessage("null")},
+		},
+		"sqlserver": {
+			change: func(plan *DatabaseResourceModel) {
+				plan.Adapter = types.StringValue("SQLSERVER")
+				plan.Postgres = nil
+				plan.Sqlserver = &DatabaseSqlserverModel{TrustServerCertificate: types.BoolValue(true)}
+			},
+			want: client.UpdateDatabaseInput{
+				Adapter:          ptr(client.DatabaseAdapterSqlserver),
+				Hostname:         ptr("db.internal:5432"),
+				PostgresOptions:  json.RawMessage("null"),
+				SqlserverOptions: json.RawMessage(`{"trustServerCertificate":true}`),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := state()
+			test.change(plan)
+
+			adapter := client.DatabaseAdapter(plan.Adapter.ValueString())
+
+			assert.Equal(t, test.want, databaseUpdateInput(plan, state(), adapter))
+		})
+	}
+}
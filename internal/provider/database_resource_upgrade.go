@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &DatabaseResource{}
+
+// databaseResourceModelV0 is the state of querydesk_database before the
+// timeouts block was added.
+type databaseResourceModelV0 struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Adapter        types.String `tfsdk:"adapter"`
+	Hostname       types.String `tfsdk:"hostname"`
+	Database       types.String `tfsdk:"database"`
+	Ssl            types.Bool   `tfsdk:"ssl"`
+	CaCertFile     types.String `tfsdk:"cacertfile"`
+	KeyFile        types.String `tfsdk:"keyfile"`
+	CertFile       types.String `tfsdk:"certfile"`
+	RestrictAccess types.Bool   `tfsdk:"restrict_access"`
+}
+
+func databaseResourceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":              schema.StringAttribute{Computed: true},
+			"name":            schema.StringAttribute{Required: true},
+			"adapter":         schema.StringAttribute{Required: true},
+			"database":        schema.StringAttribute{Required: true},
+			"hostname":        schema.StringAttribute{Required: true},
+			"ssl":             schema.BoolAttribute{Optional: true, Computed: true},
+			"cacertfile":      schema.StringAttribute{Optional: true, Sensitive: true},
+			"keyfile":         schema.StringAttribute{Optional: true, Sensitive: true},
+			"certfile":        schema.StringAttribute{Optional: true, Sensitive: true},
+			"restrict_access": schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+// databaseResourceModelV1 is the state of querydesk_database before the
+// port was split out of the hostname.
+type databaseResourceModelV1 struct {
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Adapter        types.String   `tfsdk:"adapter"`
+	Hostname       types.String   `tfsdk:"hostname"`
+	Database       types.String   `tfsdk:"database"`
+	Ssl            types.Bool     `tfsdk:"ssl"`
+	CaCertFile     types.String   `tfsdk:"cacertfile"`
+	KeyFile        types.String   `tfsdk:"keyfile"`
+	CertFile       types.String   `tfsdk:"certfile"`
+	RestrictAccess types.Bool     `tfsdk:"restrict_access"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func databaseResourceSchemaV1(ctx context.Context) *schema.Schema {
+	s := databaseResourceSchemaV0()
+	s.Version = 1
+	s.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, timeouts.Opts{
+			Create: true,
+			Read:   true,
+			Update: true,
+			Delete: true,
+		}),
+	}
+
+	return s
+}
+
+// UpgradeState upgrades state from every prior schema version directly to the
+// current one, as Terraform only calls a single upgrader.
+func (r *DatabaseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: databaseResourceSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior databaseResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := upgradeDatabaseResourceModelV1(databaseResourceModelV1{
+					Id:             prior.Id,
+					Name:           prior.Name,
+					Adapter:        prior.Adapter,
+					Hostname:       prior.Hostname,
+					Database:       prior.Database,
+					Ssl:            prior.Ssl,
+					CaCertFile:     prior.CaCertFile,
+					KeyFile:        prior.KeyFile,
+					CertFile:       prior.CertFile,
+					RestrictAccess: prior.RestrictAccess,
+					Timeouts:       nullTimeouts(),
+				})
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+		1: {
+			PriorSchema: databaseResourceSchemaV1(ctx),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior databaseResourceModelV1
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeDatabaseResourceModelV1(prior))...)
+			},
+		},
+	}
+}
+
+// upgradeDatabaseResourceModelV1 moves a port embedded in the hostname, e.g.
+// db.internal:5433, into the port attribute.
+func upgradeDatabaseResourceModelV1(prior databaseResourceModelV1) DatabaseResourceModel {
+	hostname, port := splitHostname(prior.Hostname.ValueString(), prior.Adapter.ValueString())
+
+	upgraded := DatabaseResourceModel{
+		Id:                 prior.Id,
+		Name:               prior.Name,
+		Adapter:            prior.Adapter,
+		Hostname:           types.StringValue(hostname),
+		Port:               types.Int64Value(port),
+		Database:           prior.Database,
+		Ssl:                prior.Ssl,
+		CaCertFile:         prior.CaCertFile,
+		KeyFile:            prior.KeyFile,
+		CertFile:           prior.CertFile,
+		RestrictAccess:     prior.RestrictAccess,
+		DeletionProtection: types.BoolValue(false),
+		ForceDelete:        types.BoolValue(false),
+		UpdatedAt:          types.StringNull(),
+		Timeouts:           prior.Timeouts,
+	}
+
+	if port == 0 {
+		upgraded.Port = types.Int64Null()
+	}
+
+	return upgraded
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradeState runs the upgrader r registers for version on prior, which must
+// match that version's model, and reads the result into upgraded.
+func upgradeState(t *testing.T, r resource.ResourceWithUpgradeState, version int64, prior interface{}, upgraded interface{}) {
+	ctx := context.Background()
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	require.True(t, ok, "no state upgrader for version %d", version)
+
+	priorState := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, priorState.Set(ctx, prior).HasError())
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.Greater(t, schemaResp.Schema.Version, version)
+
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &priorState}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	require.False(t, resp.State.Get(ctx, upgraded).HasError())
+}
+
+func TestDatabaseResourceUpgradeStateV0(t *testing.T) {
+	prior := databaseResourceModelV0{
+		Id:             types.StringValue("db_12345"),
+		Name:           types.StringValue("one"),
+		Adapter:        types.StringValue("POSTGRES"),
+		Hostname:       types.StringValue("localhost"),
+		Database:       types.StringValue("mydb"),
+		Ssl:            types.BoolValue(true),
+		CaCertFile:     types.StringValue("ca"),
+		KeyFile:        types.StringNull(),
+		CertFile:       types.StringNull(),
+		RestrictAccess: types.BoolValue(true),
+	}
+
+	var upgraded DatabaseResourceModel
+	upgradeState(t, &DatabaseResource{}, 0, prior, &upgraded)
+
+	assert.Equal(t, prior.Id, upgraded.Id)
+	assert.Equal(t, prior.Name, upgraded.Name)
+	assert.Equal(t, prior.Adapter, upgraded.Adapter)
+	assert.Equal(t, prior.Hostname, upgraded.Hostname)
+	assert.Equal(t, types.Int64Value(5432), upgraded.Port)
+	assert.Equal(t, prior.Database, upgraded.Database)
+	assert.Equal(t, prior.Ssl, upgraded.Ssl)
+	assert.Equal(t, prior.CaCertFile, upgraded.CaCertFile)
+	assert.True(t, upgraded.KeyFile.IsNull())
+	assert.True(t, upgraded.CertFile.IsNull())
+	assert.Equal(t, prior.RestrictAccess, upgraded.RestrictAccess)
+	assert.False(t, upgraded.DeletionProtection.ValueBool())
+	assert.False(t, upgraded.ForceDelete.ValueBool())
+	assert.True(t, upgraded.Timeouts.IsNull())
+}
+
+func TestDatabaseResourceUpgradeStateV1(t *testing.T) {
+	tests := map[string]struct {
+		adapter      string
+		hostname     string
+		wantHostname string
+		wantPort     int64
+	}{
+		"default port":   {adapter: "POSTGRES", hostname: "db.internal", wantHostname: "db.internal", wantPort: 5432},
+		"embedded port":  {adapter: "POSTGRES", hostname: "db.internal:5433", wantHostname: "db.internal", wantPort: 5433},
+		"mysql default":  {adapter: "MYSQL", hostname: "10.0.0.1", wantHostname: "10.0.0.1", wantPort: 3306},
+		"ipv6 with port": {adapter: "MYSQL", hostname: "[fd00::1]:3307", wantHostname: "[fd00::1]", wantPort: 3307},
+		"sqlserver":      {adapter: "SQLSERVER", hostname: "sql.internal", wantHostname: "sql.internal", wantPort: 1433},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			prior := databaseResourceModelV1{
+				Id:             types.StringValue("db_12345"),
+				Name:           types.StringValue("one"),
+				Adapter:        types.StringValue(test.adapter),
+				Hostname:       types.StringValue(test.hostname),
+				Database:       types.StringValue("mydb"),
+				Ssl:            types.BoolValue(false),
+				CaCertFile:     types.StringNull(),
+				KeyFile:        types.StringNull(),
+				CertFile:       types.StringNull(),
+				RestrictAccess: types.BoolValue(true),
+				Timeouts:       nullTimeouts(),
+			}
+
+			var upgraded DatabaseResourceModel
+			upgradeState(t, &DatabaseResource{}, 1, prior, &upgraded)
+
+			assert.Equal(t, test.wantHostname, upgraded.Hostname.ValueString())
+			assert.Equal(t, test.wantPort, upgraded.Port.ValueInt64())
+			assert.Equal(t, prior.Adapter, upgraded.Adapter)
+			assert.Equal(t, prior.Database, upgraded.Database)
+			assert.True(t, upgraded.Timeouts.IsNull())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &DatabaseUserResource{}
+
+// databaseUserResourceModelV0 is the state of querydesk_database_user before
+// the timeouts block was added.
+type databaseUserResourceModelV0 struct {
+	Id              types.String `tfsdk:"id"`
+	DatabaseId      types.String `tfsdk:"database_id"`
+	Description     types.String `tfsdk:"description"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	ReviewsRequired types.Int64  `tfsdk:"reviews_required"`
+}
+
+func databaseUserResourceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":               schema.StringAttribute{Computed: true},
+			"description":      schema.StringAttribute{Optional: true},
+			"username":         schema.StringAttribute{Required: true},
+			"password":         schema.StringAttribute{Required: true, Sensitive: true},
+			"reviews_required": schema.Int64Attribute{Required: true},
+			"database_id":      schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+// UpgradeState upgrades state from every prior schema version directly to the
+// current one, as Terraform only calls a single upgrader.
+func (r *DatabaseUserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: databaseUserResourceSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior databaseUserResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := DatabaseUserResourceModel{
+					Id:              prior.Id,
+					DatabaseId:      prior.DatabaseId,
+					Description:     prior.Description,
+					Username:        prior.Username,
+					Password:        prior.Password,
+					ReviewsRequired: prior.ReviewsRequired,
+					ReviewPolicyId:  types.StringNull(),
+					ConnectionUri:   types.StringNull(),
+					UpdatedAt:       types.StringNull(),
+					Timeouts:        nullTimeouts(),
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseUserResourceUpgradeStateV0(t *testing.T) {
+	prior := databaseUserResourceModelV0{
+		Id:              types.StringValue("crd_12345"),
+		DatabaseId:      types.StringValue("db_12345"),
+		Description:     types.StringNull(),
+		Username:        types.StringValue("postgres"),
+		Password:        types.StringValue("postgres"),
+		ReviewsRequired: types.Int64Value(1),
+	}
+
+	var upgraded DatabaseUserResourceModel
+	upgradeState(t, &DatabaseUserResource{}, 0, prior, &upgraded)
+
+	assert.Equal(t, prior.Id, upgraded.Id)
+	assert.Equal(t, prior.DatabaseId, upgraded.DatabaseId)
+	assert.True(t, upgraded.Description.IsNull())
+	assert.Equal(t, prior.Username, upgraded.Username)
+	assert.Equal(t, prior.Password, upgraded.Password)
+	assert.Equal(t, prior.ReviewsRequired, upgraded.ReviewsRequired)
+	assert.True(t, upgraded.Timeouts.IsNull())
+}
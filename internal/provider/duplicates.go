@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The duplicate_check values, deciding whether a name already in use fails
+// the plan or only warns.
+const (
+	duplicateCheckError   = "error"
+	duplicateCheckWarning = "warning"
+)
+
+// checkDuplicateDatabaseName checks that no other database is named name, as
+// users pick the database to query by its name. id is the database being
+// planned, unknown when it is created. Unknown names are skipped, to be
+// checked once they are known.
+func checkDuplicateDatabaseName(ctx context.Context, c client.GraphQLClient, duplicateCheck string, id types.String, name types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if name.IsNull() || name.IsUnknown() {
+		return diags
+	}
+
+	graphqlResp, err := c.FindDatabasesByName(ctx, name.ValueString())
+
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check Database Name",
+			"Could not list databases to check the name is not already in use, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	for _, database := range graphqlResp.ListDatabases {
+		if database.Id == id.ValueString() {
+			continue
+		}
+
+		addDuplicateDiagnostic(&diags, duplicateCheck, path.Root("name"),
+			"Duplicate Database Name",
+			fmt.Sprintf("Database %s is already named %s, so users could not tell the two apart when picking a database. "+
+				"Set duplicate_check to \"warning\" in the provider configuration to allow it anyway.", database.Id, name.String()),
+		)
+		break
+	}
+
+	return diags
+}
+
+// checkDuplicateUsername checks that no other user of the database has
+// username, which QueryDesk would only reject when applying. id is the user
+// being planned, unknown when it is created. Unknown values are skipped, to be
+// checked once they are known.
+func checkDuplicateUsername(ctx context.Context, c client.GraphQLClient, duplicateCheck string, id types.String, databaseId types.String, username types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if databaseId.IsNull() || databaseId.IsUnknown() || username.IsNull() || username.IsUnknown() {
+		return diags
+	}
+
+	graphqlResp, err := c.FindCredentialsByUsername(ctx, databaseId.ValueString(), username.ValueString())
+
+	// Left to apply, which reports the missing database
+	if errors.Is(err, client.ErrNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check Database Username",
+			"Could not list the users of the database to check the username is not already in use, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	for _, credential := range graphqlResp.Database.Credentials {
+		if credential.Id == id.ValueString() {
+			continue
+		}
+
+		addDuplicateDiagnostic(&diags, duplicateCheck, path.Root("username"),
+			"Duplicate Database Username",
+			fmt.Sprintf("Database user %s of database %s already has the username %s, so QueryDesk would reject this one when applying.",
+				credential.Id, databaseId.String(), username.String()),
+		)
+		break
+	}
+
+	return diags
+}
+
+func addDuplicateDiagnostic(diags *diag.Diagnostics, duplicateCheck string, attributePath path.Path, summary string, detail string) {
+	if duplicateCheck == duplicateCheckWarning {
+		diags.AddAttributeWarning(attributePath, summary, detail)
+		return
+	}
+
+	diags.AddAttributeError(attributePath, summary, detail)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckDuplicateDatabaseName(t *testing.T) {
+	tests := map[string]struct {
+		id             types.String
+		duplicateCheck string
+		resp           *client.FindDatabasesByNameResponse
+		err            error
+		wantSummary    string
+		wantSeverity   diag.Severity
+	}{
+		"unused": {
+			id:   types.StringUnknown(),
+			resp: &client.FindDatabasesByNameResponse{},
+		},
+		"used by another database": {
+			id:             types.StringUnknown(),
+			duplicateCheck: duplicateCheckError,
+			resp: &client.FindDatabasesByNameResponse{
+				ListDatabases: []client.FindDatabasesByNameListDatabasesDatabase{{Id: "db_other"}},
+			},
+			wantSummary:  "Duplicate Database Name",
+			wantSeverity: diag.SeverityError,
+		},
+		"used by another database, warning": {
+			id:             types.StringUnknown(),
+			duplicateCheck: duplicateCheckWarning,
+			resp: &client.FindDatabasesByNameResponse{
+				ListDatabases: []client.FindDatabasesByNameListDatabasesDatabase{{Id: "db_other"}},
+			},
+			wantSummary:  "Duplicate Database Name",
+			wantSeverity: diag.SeverityWarning,
+		},
+		"only used by itself": {
+			id:             types.StringValue("db_12345"),
+			duplicateCheck: duplicateCheckError,
+			resp: &client.FindDatabasesByNameResponse{
+				ListDatabases: []client.FindDatabasesByNameListDatabasesDatabase{{Id: "db_12345"}},
+			},
+		},
+		"api error": {
+			id:           types.StringUnknown(),
+			err:          errors.New("connection refused"),
+			wantSummary:  "Unable to Check Database Name",
+			wantSeverity: diag.SeverityWarning,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+			mockClient.EXPECT().FindDatabasesByName(mock.Anything, "payments").Return(test.resp, test.err)
+
+			diags := checkDuplicateDatabaseName(context.Background(), mockClient, test.duplicateCheck, test.id, types.StringValue("payments"))
+
+			if test.wantSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, test.wantSummary, diags[0].Summary())
+				assert.Equal(t, test.wantSeverity, diags[0].Severity())
+			}
+		})
+	}
+
+	// Unknown names are checked once they are known, without calling the API.
+	assert.Empty(t, checkDuplicateDatabaseName(context.Background(), client.NewMockGraphQLClient(t), duplicateCheckError, types.StringUnknown(), types.StringUnknown()))
+}
+
+func TestCheckDuplicateUsername(t *testing.T) {
+	tests := map[string]struct {
+		id          types.String
+		resp        *client.FindCredentialsByUsernameResponse
+		err         error
+		wantSummary string
+	}{
+		"unused": {
+			id:   types.StringUnknown(),
+			resp: &client.FindCredentialsByUsernameResponse{},
+		},
+		"used by another user": {
+			id: types.StringUnknown(),
+			resp: &client.FindCredentialsByUsernameResponse{
+				Database: client.FindCredentialsByUsernameDatabase{
+					Id:          "db_12345",
+					Credentials: []client.FindCredentialsByUsernameDatabaseCredentialsCredential{{Id: "crd_other"}},
+				},
+			},
+			wantSummary: "Duplicate Database Username",
+		},
+		"only used by itself": {
+			id: types.StringValue("crd_12345"),
+			resp: &client.FindCredentialsByUsernameResponse{
+				Database: client.FindCredentialsByUsernameDatabase{
+					Id:          "db_12345",
+					Credentials: []client.FindCredentialsByUsernameDatabaseCredentialsCredential{{Id: "crd_12345"}},
+				},
+			},
+		},
+		"database deleted": {
+			id:  types.StringUnknown(),
+			err: fmt.Errorf("%w (request id: 1)", client.ErrNotFound),
+		},
+		"api error": {
+			id:          types.StringUnknown(),
+			err:         errors.New("connection refused"),
+			wantSummary: "Unable to Check Database Username",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+			mockClient.EXPECT().FindCredentialsByUsername(mock.Anything, "db_12345", "reporting").Return(test.resp, test.err)
+
+			diags := checkDuplicateUsername(context.Background(), mockClient, duplicateCheckError, test.id, types.StringValue("db_12345"), types.StringValue("reporting"))
+
+			if test.wantSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, test.wantSummary, diags[0].Summary())
+			}
+		})
+	}
+
+	// The database may be created in the same apply, so its id is not known yet.
+	assert.Empty(t, checkDuplicateUsername(context.Background(), client.NewMockGraphQLClient(t), duplicateCheckError, types.StringUnknown(), types.StringUnknown(), types.StringValue("reporting")))
+}
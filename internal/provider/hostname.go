@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-querydesk/internal/dbconn"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultPorts are the ports each adapter listens on unless configured
+// otherwise. The port is left out of the hostname sent to QueryDesk when it
+// matches the default.
+var defaultPorts = map[string]int64{
+	"POSTGRES":  5432,
+	"MYSQL":     3306,
+	"SQLSERVER": 1433,
+}
+
+var dnsLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// validateHostname checks that hostname is a DNS name, an IPv4 address or a
+// bracketed IPv6 address, without a scheme, port or path.
+func validateHostname(hostname string) error {
+	if hostname == "" {
+		return fmt.Errorf("hostname must not be empty")
+	}
+
+	if strings.Contains(hostname, "://") {
+		return fmt.Errorf("%q must not include a scheme such as postgres://", hostname)
+	}
+
+	if strings.ContainsAny(hostname, "/?#@") {
+		return fmt.Errorf("%q must not include a path, query or user info", hostname)
+	}
+
+	if strings.HasPrefix(hostname, "[") {
+		if !strings.HasSuffix(hostname, "]") {
+			return fmt.Errorf("%q must not include a port, set the port attribute instead", hostname)
+		}
+
+		ip := net.ParseIP(hostname[1 : len(hostname)-1])
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%q is not a valid IPv6 address", hostname)
+		}
+
+		return nil
+	}
+
+	if ip := net.ParseIP(hostname); ip != nil {
+		if ip.To4() == nil {
+			return fmt.Errorf("IPv6 address %q must be enclosed in brackets, e.g. [%s]", hostname, hostname)
+		}
+
+		return nil
+	}
+
+	if strings.Contains(hostname, ":") {
+		return fmt.Errorf("%q must not include a port, set the port attribute instead", hostname)
+	}
+
+	name := strings.TrimSuffix(hostname, ".")
+	if len(name) > 253 {
+		return fmt.Errorf("%q is longer than 253 characters", hostname)
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if !dnsLabel.MatchString(label) {
+			return fmt.Errorf("%q is not a valid DNS name", hostname)
+		}
+	}
+
+	return nil
+}
+
+// splitHostname splits a hostname as stored by QueryDesk into the host and
+// port, falling back to the default port of adapter.
+func splitHostname(hostname string, adapter string) (string, int64) {
+	if host, port, ok := dbconn.SplitHostname(hostname); ok {
+		return host, port
+	}
+
+	return hostname, defaultPorts[adapter]
+}
+
+// joinHostname combines host and port into the hostname sent to QueryDesk,
+// leaving out the port if it is the default for adapter.
+func joinHostname(host string, port int64, adapter string) string {
+	if port == 0 || port == defaultPorts[adapter] {
+		return host
+	}
+
+	return host + ":" + strconv.FormatInt(port, 10)
+}
+
+type hostnameValidator struct{}
+
+func (v hostnameValidator) Description(ctx context.Context) string {
+	return "value must be a DNS name, IPv4 address or bracketed IPv6 address, without a scheme, port or path"
+}
+
+func (v hostnameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostnameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateHostname(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Hostname", err.Error())
+	}
+}
+
+type portValidator struct{}
+
+func (v portValidator) Description(ctx context.Context) string {
+	return "value must be a port between 1 and 65535"
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if port := req.ConfigValue.ValueInt64(); port < 1 || port > 65535 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Port", fmt.Sprintf("Expected a port between 1 and 65535, got: %d.", port))
+	}
+}
+
+// defaultPortModifier plans the default port of the configured adapter when
+// no port is set.
+type defaultPortModifier struct{}
+
+func (m defaultPortModifier) Description(ctx context.Context) string {
+	return "defaults to the standard port of the adapter"
+}
+
+func (m defaultPortModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultPortModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var adapter types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("adapter"), &adapter)...)
+
+	if port, ok := defaultPorts[adapter.ValueString()]; ok {
+		resp.PlanValue = types.Int64Value(port)
+	}
+}
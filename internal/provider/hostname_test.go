@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHostname(t *testing.T) {
+	valid := []string{
+		"localhost",
+		"db.internal",
+		"db-1.eu-west-1.rds.amazonaws.com",
+		"db.internal.",
+		"10.0.0.1",
+		"[::1]",
+		"[fd00::1]",
+	}
+
+	for _, hostname := range valid {
+		assert.NoError(t, validateHostname(hostname), hostname)
+	}
+
+	invalid := map[string]string{
+		"":                       "must not be empty",
+		"postgres://db.internal": "must not include a scheme",
+		"db.internal/mydb":       "must not include a path",
+		"user@db.internal":       "must not include a path, query or user info",
+		"db.internal:5433":       "must not include a port",
+		"10.0.0.1:5433":          "must not include a port",
+		"[::1]:5433":             "must not include a port",
+		"::1":                    "must be enclosed in brackets",
+		"[10.0.0.1]":             "not a valid IPv6 address",
+		"[db.internal]":          "not a valid IPv6 address",
+		"db_1.internal":          "not a valid DNS name",
+		"-db.internal":           "not a valid DNS name",
+		"db..internal":           "not a valid DNS name",
+		"db internal":            "not a valid DNS name",
+	}
+
+	for hostname, want := range invalid {
+		assert.ErrorContains(t, validateHostname(hostname), want, hostname)
+	}
+}
+
+func TestSplitHostname(t *testing.T) {
+	tests := []struct {
+		hostname string
+		adapter  string
+		wantHost string
+		wantPort int64
+	}{
+		{"db.internal", "POSTGRES", "db.internal", 5432},
+		{"db.internal", "MYSQL", "db.internal", 3306},
+		{"db.internal:5433", "POSTGRES", "db.internal", 5433},
+		{"10.0.0.1:3307", "MYSQL", "10.0.0.1", 3307},
+		{"[::1]", "POSTGRES", "[::1]", 5432},
+		{"[::1]:5433", "POSTGRES", "[::1]", 5433},
+		{"db.internal:port", "POSTGRES", "db.internal:port", 5432},
+	}
+
+	for _, test := range tests {
+		host, port := splitHostname(test.hostname, test.adapter)
+		assert.Equal(t, test.wantHost, host, test.hostname)
+		assert.Equal(t, test.wantPort, port, test.hostname)
+	}
+}
+
+func TestJoinHostname(t *testing.T) {
+	assert.Equal(t, "db.internal", joinHostname("db.internal", 5432, "POSTGRES"))
+	assert.Equal(t, "db.internal:5433", joinHostname("db.internal", 5433, "POSTGRES"))
+	assert.Equal(t, "db.internal:5432", joinHostname("db.internal", 5432, "MYSQL"))
+	assert.Equal(t, "db.internal", joinHostname("db.internal", 0, "MYSQL"))
+	assert.Equal(t, "[::1]:5433", joinHostname("[::1]", 5433, "POSTGRES"))
+}
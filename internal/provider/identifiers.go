@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-querydesk/internal/client"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identifierRule is what an adapter accepts as a database name or username.
+// Zero limits are not checked.
+type identifierRule struct {
+	maxBytes        int
+	maxChars        int
+	forbidden       string
+	noTrailingSpace bool
+}
+
+// databaseNameRules are keyed by adapter. Postgres truncates identifiers
+// longer than NAMEDATALEN - 1 bytes, MySQL stores databases as directories and
+// SQL Server names are sysname.
+var databaseNameRules = map[string]identifierRule{
+	"POSTGRES":  {maxBytes: 63, forbidden: "\x00"},
+	"MYSQL":     {maxChars: 64, forbidden: "/\\.\x00", noTrailingSpace: true},
+	"SQLSERVER": {maxChars: 128, forbidden: "\x00"},
+}
+
+// usernameRules are keyed by adapter, MySQL usernames are limited to 32
+// characters since 5.7.8.
+var usernameRules = map[string]identifierRule{
+	"POSTGRES":  {maxBytes: 63, forbidden: "\x00"},
+	"MYSQL":     {maxChars: 32, forbidden: "\x00"},
+	"SQLSERVER": {maxChars: 128, forbidden: "\x00"},
+}
+
+// check returns why value is not accepted, or "" when it is.
+func (r identifierRule) check(value string) string {
+	if r.maxBytes > 0 && len(value) > r.maxBytes {
+		return fmt.Sprintf("must be at most %d bytes, got %d", r.maxBytes, len(value))
+	}
+
+	if chars := utf8.RuneCountInString(value); r.maxChars > 0 && chars > r.maxChars {
+		return fmt.Sprintf("must be at most %d characters, got %d", r.maxChars, chars)
+	}
+
+	if i := strings.IndexAny(value, r.forbidden); i >= 0 {
+		return fmt.Sprintf("must not contain %q", value[i])
+	}
+
+	if r.noTrailingSpace && strings.HasSuffix(value, " ") {
+		return "must not end with a space"
+	}
+
+	return ""
+}
+
+// databaseNameValidator checks the database attribute against the rules of
+// the configured adapter.
+type databaseNameValidator struct{}
+
+func (v databaseNameValidator) Description(ctx context.Context) string {
+	return "value must be a database name the adapter accepts"
+}
+
+func (v databaseNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v databaseNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var adapter types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adapter"), &adapter)...)
+
+	if problem := databaseNameRules[adapter.ValueString()].check(req.ConfigValue.ValueString()); problem != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Database Name",
+			fmt.Sprintf("%s database names %s: %s.", adapter.ValueString(), problem, req.ConfigValue.String()),
+		)
+	}
+}
+
+// checkUsernameRules checks username against the rules of the adapter of the
+// database it is planned for. Unknown values are skipped, to be checked once
+// they are known.
+func checkUsernameRules(ctx context.Context, c client.GraphQLClient, databaseId types.String, username types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if databaseId.IsNull() || databaseId.IsUnknown() || username.IsNull() || username.IsUnknown() {
+		return diags
+	}
+
+	graphqlResp, err := c.GetDatabase(ctx, databaseId.ValueString())
+
+	// Left to apply, which reports the missing database
+	if errors.Is(err, client.ErrNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check Database Username",
+			"Could not read the database to check the username against its adapter, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	adapter := string(graphqlResp.Database.Adapter)
+
+	if problem := usernameRules[adapter].check(username.ValueString()); problem != "" {
+		diags.AddAttributeError(
+			path.Root("username"),
+			"Invalid Database Username",
+			fmt.Sprintf("%s usernames %s: %s.", adapter, problem, username.String()),
+		)
+	}
+
+	return diags
+}
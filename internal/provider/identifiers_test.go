@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIdentifierRuleCheck(t *testing.T) {
+	tests := map[string]struct {
+		rule  identifierRule
+		value string
+		want  string
+	}{
+		"postgres database at limit": {
+			rule:  databaseNameRules["POSTGRES"],
+			value: strings.Repeat("a", 63),
+		},
+		"postgres database too long": {
+			rule:  databaseNameRules["POSTGRES"],
+			value: strings.Repeat("a", 64),
+			want:  "must be at most 63 bytes, got 64",
+		},
+		"postgres counts bytes": {
+			rule:  usernameRules["POSTGRES"],
+			value: strings.Repeat("é", 32),
+			want:  "must be at most 63 bytes, got 64",
+		},
+		"mysql username at limit": {
+			rule:  usernameRules["MYSQL"],
+			value: strings.Repeat("é", 32),
+		},
+		"mysql username too long": {
+			rule:  usernameRules["MYSQL"],
+			value: strings.Repeat("a", 33),
+			want:  "must be at most 32 characters, got 33",
+		},
+		"mysql database with a dot": {
+			rule:  databaseNameRules["MYSQL"],
+			value: "billing.v2",
+			want:  `must not contain '.'`,
+		},
+		"mysql database ending with a space": {
+			rule:  databaseNameRules["MYSQL"],
+			value: "billing ",
+			want:  "must not end with a space",
+		},
+		"sqlserver database": {
+			rule:  databaseNameRules["SQLSERVER"],
+			value: "billing.v2",
+		},
+		"unknown adapter": {
+			rule:  databaseNameRules["ORACLE"],
+			value: strings.Repeat("a", 200),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.rule.check(test.value))
+		})
+	}
+}
+
+func TestCheckUsernameRules(t *testing.T) {
+	tests := map[string]struct {
+		resp        *client.GetDatabaseResponse
+		err         error
+		wantSummary string
+	}{
+		"fits": {
+			resp: &client.GetDatabaseResponse{Database: client.GetDatabaseDatabase{Id: "db_12345", Adapter: client.DatabaseAdapterPostgres}},
+		},
+		"too long for the adapter": {
+			resp:        &client.GetDatabaseResponse{Database: client.GetDatabaseDatabase{Id: "db_12345", Adapter: client.DatabaseAdapterMysql}},
+			wantSummary: "Invalid Database Username",
+		},
+		"database deleted": {
+			err: fmt.Errorf("%w (request id: 1)", client.ErrNotFound),
+		},
+		"api error": {
+			err:         errors.New("connection refused"),
+			wantSummary: "Unable to Check Database Username",
+		},
+	}
+
+	username := types.StringValue("reporting_readonly_service_account")
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+			mockClient.EXPECT().GetDatabase(mock.Anything, "db_12345").Return(test.resp, test.err)
+
+			diags := checkUsernameRules(context.Background(), mockClient, types.StringValue("db_12345"), username)
+
+			if test.wantSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, test.wantSummary, diags[0].Summary())
+			}
+		})
+	}
+
+	// The database may be created in the same apply, so its id is not known yet.
+	assert.Empty(t, checkUsernameRules(context.Background(), client.NewMockGraphQLClient(t), types.StringUnknown(), username))
+}
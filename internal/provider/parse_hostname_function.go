@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-querydesk/internal/dbconn"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseHostnameFunction{}
+
+func NewParseHostnameFunction() function.Function {
+	return &ParseHostnameFunction{}
+}
+
+// ParseHostnameFunction splits a host:port string into the hostname and port
+// attributes of querydesk_database.
+type ParseHostnameFunction struct{}
+
+type parseHostnameModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+}
+
+func (f *ParseHostnameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_hostname"
+}
+
+func (f *ParseHostnameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a host:port string",
+		MarkdownDescription: "Splits a string such as `db.internal:5433` or `[::1]:5432` into an object with `hostname` and `port`, " +
+			"matching the attributes of `querydesk_database`. The port is null if the string does not include one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hostname",
+				MarkdownDescription: "A DNS name, IPv4 address or bracketed IPv6 address, optionally followed by `:port`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"hostname": types.StringType,
+				"port":     types.Int64Type,
+			},
+		},
+	}
+}
+
+func (f *ParseHostnameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hostname string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &hostname))
+
+	if resp.Error != nil {
+		return
+	}
+
+	host, port, hasPort := dbconn.SplitHostname(hostname)
+
+	if err := validateHostname(host); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := parseHostnameModel{
+		Hostname: types.StringValue(host),
+		Port:     types.Int64Null(),
+	}
+
+	if hasPort {
+		if port < 1 || port > 65535 {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected a port between 1 and 65535, got: %d", port))
+			return
+		}
+
+		result.Port = types.Int64Value(port)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
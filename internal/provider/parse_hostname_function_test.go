@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHostnameFunction(t *testing.T) {
+	resultTypes := map[string]attr.Type{
+		"hostname": types.StringType,
+		"port":     types.Int64Type,
+	}
+
+	tests := map[string]struct {
+		hostname     string
+		wantHostname string
+		wantPort     types.Int64
+		wantErr      string
+	}{
+		"dns name":          {hostname: "db.internal", wantHostname: "db.internal", wantPort: types.Int64Null()},
+		"dns name and port": {hostname: "db.internal:5433", wantHostname: "db.internal", wantPort: types.Int64Value(5433)},
+		"ipv4 and port":     {hostname: "10.0.0.1:3306", wantHostname: "10.0.0.1", wantPort: types.Int64Value(3306)},
+		"ipv6":              {hostname: "[::1]", wantHostname: "[::1]", wantPort: types.Int64Null()},
+		"ipv6 and port":     {hostname: "[::1]:5432", wantHostname: "[::1]", wantPort: types.Int64Value(5432)},
+		"port out of range": {hostname: "db.internal:70000", wantErr: "between 1 and 65535"},
+		"port zero":         {hostname: "db.internal:0", wantErr: "between 1 and 65535"},
+		"invalid port":      {hostname: "db.internal:pg", wantErr: "must not include a port"},
+		"scheme":            {hostname: "postgres://db.internal", wantErr: "must not include a scheme"},
+		"empty":             {hostname: "", wantErr: "must not be empty"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(resultTypes))}
+
+			NewParseHostnameFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(test.hostname)}),
+			}, &resp)
+
+			if test.wantErr != "" {
+				if assert.NotNil(t, resp.Error) {
+					assert.Contains(t, resp.Error.Error(), test.wantErr)
+				}
+				return
+			}
+
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.ObjectValueMust(resultTypes, map[string]attr.Value{
+				"hostname": types.StringValue(test.wantHostname),
+				"port":     test.wantPort,
+			}), resp.Result.Value())
+		})
+	}
+}
@@ -5,10 +5,18 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 	"terraform-provider-querydesk/internal/client"
+	"time"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,6 +26,8 @@ import (
 
 // Ensure QueryDeskProvider satisfies various provider interfaces.
 var _ provider.Provider = &QueryDeskProvider{}
+var _ provider.ProviderWithFunctions = &QueryDeskProvider{}
+var _ provider.ProviderWithEphemeralResources = &QueryDeskProvider{}
 
 // QueryDeskProvider defines the provider implementation.
 type QueryDeskProvider struct {
@@ -30,10 +40,32 @@ type QueryDeskProvider struct {
 
 // QueryDeskProviderModel describes the provider data model.
 type QueryDeskProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host               types.String `tfsdk:"host"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	Profile            types.String `tfsdk:"profile"`
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertPem      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPem       types.String `tfsdk:"client_key_pem"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+	CheckSchema               types.Bool   `tfsdk:"check_schema"`
+	DuplicateCheck            types.String `tfsdk:"duplicate_check"`
+}
+
+// providerData is passed to the Configure method of every resource, data
+// source and ephemeral resource.
+type providerData struct {
+	client         client.GraphQLClient
+	duplicateCheck string
 }
 
+// defaultRequestTimeout is used when request_timeout is not configured.
+const defaultRequestTimeout = time.Minute
+
 func (p *QueryDeskProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "querydesk"
 	resp.Version = p.version
@@ -43,11 +75,62 @@ func (p *QueryDeskProvider) Schema(ctx context.Context, req provider.SchemaReque
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Required: true,
+				MarkdownDescription: "The QueryDesk API host, e.g. `https://api.querydesk.com`. Can also be set with the `QUERYDESK_HOST` environment variable or a credentials profile.",
+				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				MarkdownDescription: "The QueryDesk API key. Can also be set with the `QUERYDESK_API_KEY` environment variable or a credentials profile.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The profile of the QueryDesk credentials file to read `host` and `api_key` from when they are not set in the configuration or environment. " +
+					"Can also be set with the `QUERYDESK_PROFILE` environment variable, defaults to `default`. " +
+					"The credentials file is read from `~/.querydesk/credentials` unless `QUERYDESK_CREDENTIALS_FILE` is set.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate authorities to trust when connecting to the QueryDesk API, in addition to the system pool. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded certificate authorities to trust when connecting to the QueryDesk API, in addition to the system pool. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to skip verification of the QueryDesk API certificate. Only use this for testing.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS with the QueryDesk API, `client_key_pem` must also be set.",
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client key for mutual TLS with the QueryDesk API, `client_cert_pem` must also be set.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to connect to the QueryDesk API through. Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long a single request to the QueryDesk API may take, such as `30s` or `2m`. Defaults to `1m`.",
+				Optional:            true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to skip checking the host and api key against the QueryDesk API when the provider is configured, e.g. for offline plans.",
+				Optional:            true,
+			},
+			"check_schema": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to compare the QueryDesk API schema with the one this provider was built against when the provider is configured, " +
+					"and warn about removed fields, changed database adapters or new required inputs before any resource is changed.",
+				Optional: true,
+			},
+			"duplicate_check": schema.StringAttribute{
+				MarkdownDescription: "How a `querydesk_database` name or `querydesk_database_user` username that is already in use is reported when planning, " +
+					"one of `error` or `warning`. Defaults to `error`.",
+				Optional: true,
 			},
 		},
 	}
@@ -80,19 +163,34 @@ func (p *QueryDeskProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if data.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown QueryDesk Profile",
+			"The provider cannot create the QueryDesk API client as there is an unknown configuration value for the QueryDesk profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the QUERYDESK_PROFILE environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	host := os.Getenv("QUERYDESK_HOST")
-	api_key := os.Getenv("QUERYDESK_API_KEY")
+	host, api_key, diags := resolveCredentials(data, os.Getenv)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.Host.IsNull() {
-		host = data.Host.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.ApiKey.IsNull() {
-		api_key = data.ApiKey.ValueString()
+	if host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Missing QueryDesk API Host",
+			"The provider cannot create the QueryDesk API client as there is a missing or empty value for the QueryDesk API host. "+
+				"Set the host value in the configuration, use the QUERYDESK_HOST environment variable or select a credentials profile. "+
+				"If either is already set, ensure the value is not empty.",
+		)
 	}
 
 	if api_key == "" {
@@ -100,16 +198,59 @@ func (p *QueryDeskProvider) Configure(ctx context.Context, req provider.Configur
 			path.Root("api_key"),
 			"Missing QueryDesk API Key",
 			"The provider cannot create the QueryDesk API client as there is a missing or empty value for the QueryDesk API key. "+
-				"Set the api key value in the configuration or use the QUERYDESK_API_KEY environment variable. "+
+				"Set the api key value in the configuration, use the QUERYDESK_API_KEY environment variable or select a credentials profile. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	if !data.CaCertPem.IsNull() && !data.CaCertFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Conflicting QueryDesk CA Certificate",
+			"Only one of ca_cert_pem and ca_cert_file can be set.",
+		)
+	}
+
+	if data.ClientCertPem.IsNull() != data.ClientKeyPem.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert_pem"),
+			"Incomplete QueryDesk Client Certificate",
+			"Both client_cert_pem and client_key_pem must be set to use a client certificate.",
+		)
+	}
+
+	requestTimeout, diags := parseRequestTimeout(data.RequestTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	duplicateCheck := duplicateCheckError
+	if !data.DuplicateCheck.IsNull() {
+		duplicateCheck = data.DuplicateCheck.ValueString()
+
+		if duplicateCheck != duplicateCheckError && duplicateCheck != duplicateCheckWarning {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duplicate_check"),
+				"Invalid Duplicate Check",
+				fmt.Sprintf("The duplicate check must be \"error\" or \"warning\", got: %s.", data.DuplicateCheck.String()),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	graphqlClient, err := client.NewClient(&host, &api_key)
+	clientOpts := client.ClientOptions{
+		CACertPEM:          data.CaCertPem.ValueString(),
+		CACertFile:         data.CaCertFile.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ClientCertPEM:      data.ClientCertPem.ValueString(),
+		ClientKeyPEM:       data.ClientKeyPem.ValueString(),
+		ProxyURL:           data.ProxyUrl.ValueString(),
+		RequestTimeout:     requestTimeout,
+		UserAgent:          fmt.Sprintf("terraform-provider-querydesk/%s terraform/%s", p.version, req.TerraformVersion),
+	}
+
+	graphqlClient, err := client.NewClient(&host, &api_key, clientOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create QueryDesk API Client",
@@ -128,19 +269,145 @@ func (p *QueryDeskProvider) Configure(ctx context.Context, req provider.Configur
 		myclient = p.testClient
 	}
 
-	resp.DataSourceData = myclient
-	resp.ResourceData = myclient
+	if !data.SkipCredentialsValidation.ValueBool() {
+		resp.Diagnostics.Append(validateCredentials(ctx, myclient, host)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The test client only mocks the generated operations, so there is no
+	// API to introspect.
+	if data.CheckSchema.ValueBool() && p.testClient == nil {
+		resp.Diagnostics.Append(checkSchema(ctx, *graphqlClient, host)...)
+	}
+
+	resp.DataSourceData = providerData{client: myclient, duplicateCheck: duplicateCheck}
+	resp.ResourceData = resp.DataSourceData
+	resp.EphemeralResourceData = resp.DataSourceData
+}
+
+// parseRequestTimeout returns the configured request_timeout, or
+// defaultRequestTimeout when it is not set.
+func parseRequestTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return defaultRequestTimeout, diags
+	}
+
+	parsed, err := time.ParseDuration(value.ValueString())
+	if err != nil || parsed <= 0 {
+		diags.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid QueryDesk Request Timeout",
+			fmt.Sprintf("The request timeout must be a positive duration such as \"30s\" or \"2m\", got: %s.", value.String()),
+		)
+	}
+
+	return parsed, diags
+}
+
+// checkSchema warns about differences between the live API schema and the
+// bundled one that are likely to make resource operations fail.
+func checkSchema(ctx context.Context, c graphql.Client, host string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	problems, err := client.CheckSchema(ctx, c)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check QueryDesk API Schema",
+			fmt.Sprintf("The provider could not introspect the schema of the QueryDesk API at %s, resource operations may still succeed.\n\n"+
+				"QueryDesk Client Error: %s", host, err),
+		)
+
+		return diags
+	}
+
+	if len(problems) > 0 {
+		diags.AddWarning(
+			"QueryDesk API Schema Mismatch",
+			fmt.Sprintf("The QueryDesk API at %s is not compatible with the schema this provider version was built against, so some resource operations may fail. "+
+				"Check for a newer provider version.\n\n- %s", host, strings.Join(problems, "\n- ")),
+		)
+	}
+
+	return diags
+}
+
+// validateCredentials makes a lightweight authenticated request so that an
+// invalid api key or host is reported before any resource operation.
+func validateCredentials(ctx context.Context, c client.GraphQLClient, host string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pingResp, err := c.Ping(ctx)
+
+	switch {
+	case errors.Is(err, client.ErrUnauthorized):
+		diags.AddAttributeError(
+			path.Root("api_key"),
+			"Invalid QueryDesk API Key",
+			fmt.Sprintf("The QueryDesk API at %s rejected the configured API key. "+
+				"Check that the key has not been revoked and belongs to this QueryDesk instance.\n\n"+
+				"QueryDesk Client Error: %s", host, err),
+		)
+	case errors.Is(err, client.ErrUnexpectedResponse), err == nil && pingResp.Typename == "":
+		detail := "the response did not contain the expected GraphQL data"
+		if err != nil {
+			detail = err.Error()
+		}
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Host Is Not a QueryDesk Server",
+			fmt.Sprintf("%s does not look like a QueryDesk API server. "+
+				"Check that the host points at your QueryDesk instance, without a /graphql suffix.\n\n"+
+				"QueryDesk Client Error: %s", host, detail),
+		)
+	case err != nil:
+		diags.AddError(
+			"Unable to Reach QueryDesk API",
+			fmt.Sprintf("The provider could not validate its credentials against %s. "+
+				"Set skip_credentials_validation to true to skip this check, e.g. for offline plans.\n\n"+
+				"QueryDesk Client Error: %s", host, err),
+		)
+	}
+
+	return diags
 }
 
 func (p *QueryDeskProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDatabaseResource,
 		NewDatabaseUserResource,
+		NewDatabaseAccessResource,
+		NewUserResource,
+		NewTeamResource,
+		NewTeamMembershipResource,
+		NewReviewPolicyResource,
+		NewApiKeyResource,
+	}
+}
+
+func (p *QueryDeskProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseUserEphemeralResource,
 	}
 }
 
 func (p *QueryDeskProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewCurrentIdentityDataSource,
+		NewAuditEventsDataSource,
+	}
+}
+
+func (p *QueryDeskProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewConnectionURIFunction,
+		NewParseHostnameFunction,
+		NewCertFingerprintFunction,
+	}
 }
 
 func New(version string, client client.GraphQLClient) func() provider.Provider {
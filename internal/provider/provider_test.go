@@ -4,11 +4,23 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"terraform-provider-querydesk/internal/client"
 	"testing"
+	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -16,6 +28,8 @@ const (
 provider "querydesk" {
 	host    = "http://localhost:4000"
 	api_key = "test"
+
+	skip_credentials_validation = true
 }
 `
 )
@@ -35,3 +49,136 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestValidateCredentials(t *testing.T) {
+	tests := map[string]struct {
+		resp      *client.PingResponse
+		err       error
+		wantPath  path.Path
+		wantError string
+	}{
+		"valid": {
+			resp: &client.PingResponse{Typename: "RootQueryType"},
+		},
+		"unauthorized": {
+			err:       fmt.Errorf("%w: 401 Unauthorized", client.ErrUnauthorized),
+			wantPath:  path.Root("api_key"),
+			wantError: "Invalid QueryDesk API Key",
+		},
+		"not querydesk": {
+			err:       fmt.Errorf("%w: returned content type \"text/html\"", client.ErrUnexpectedResponse),
+			wantPath:  path.Root("host"),
+			wantError: "Host Is Not a QueryDesk Server",
+		},
+		"empty response": {
+			resp:      &client.PingResponse{},
+			wantPath:  path.Root("host"),
+			wantError: "Host Is Not a QueryDesk Server",
+		},
+		"unreachable": {
+			err:       errors.New("dial tcp: connection refused"),
+			wantError: "Unable to Reach QueryDesk API",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+			mockClient.EXPECT().Ping(mock.Anything).Return(test.resp, test.err)
+
+			diags := validateCredentials(context.Background(), mockClient, "http://localhost:4000")
+
+			if test.wantError == "" {
+				assert.False(t, diags.HasError())
+				return
+			}
+
+			require.Len(t, diags, 1)
+			assert.Equal(t, test.wantError, diags[0].Summary())
+
+			withPath, ok := diags[0].(diag.DiagnosticWithPath)
+			assert.Equal(t, len(test.wantPath.Steps()) > 0, ok)
+			if ok {
+				assert.Equal(t, test.wantPath, withPath.Path())
+			}
+		})
+	}
+}
+
+// fakeIntrospection answers schema introspection with canned JSON.
+type fakeIntrospection struct {
+	data string
+	err  error
+}
+
+func (f fakeIntrospection) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	return json.Unmarshal([]byte(f.data), resp.Data)
+}
+
+func TestCheckSchema(t *testing.T) {
+	diags := checkSchema(context.Background(), fakeIntrospection{err: errors.New("connection refused")}, "http://localhost:4000")
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Equal(t, "Unable to Check QueryDesk API Schema", diags[0].Summary())
+
+	diags = checkSchema(context.Background(), fakeIntrospection{data: `{"__schema":{"types":[
+		{"kind":"ENUM","name":"DatabaseAdapter","enumValues":[{"name":"POSTGRES"}]}
+	]}}`}, "http://localhost:4000")
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Equal(t, "QueryDesk API Schema Mismatch", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "- enum value DatabaseAdapter.MYSQL was removed")
+}
+
+func TestParseRequestTimeout(t *testing.T) {
+	tests := map[string]struct {
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		"not set": {
+			value: types.StringNull(),
+			want:  defaultRequestTimeout,
+		},
+		"seconds": {
+			value: types.StringValue("30s"),
+			want:  30 * time.Second,
+		},
+		"minutes": {
+			value: types.StringValue("2m"),
+			want:  2 * time.Minute,
+		},
+		"no unit": {
+			value:   types.StringValue("30"),
+			wantErr: true,
+		},
+		"zero": {
+			value:   types.StringValue("0s"),
+			wantErr: true,
+		},
+		"negative": {
+			value:   types.StringValue("-1m"),
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			timeout, diags := parseRequestTimeout(test.value)
+
+			if test.wantErr {
+				require.Len(t, diags, 1)
+				assert.Equal(t, "Invalid QueryDesk Request Timeout", diags[0].Summary())
+				assert.Equal(t, path.Root("request_timeout"), diags[0].(diag.DiagnosticWithPath).Path())
+				return
+			}
+
+			assert.Empty(t, diags)
+			assert.Equal(t, test.want, timeout)
+		})
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReviewPolicyResource{}
+var _ resource.ResourceWithConfigure = &ReviewPolicyResource{}
+var _ resource.ResourceWithImportState = &ReviewPolicyResource{}
+
+func NewReviewPolicyResource() resource.Resource {
+	return &ReviewPolicyResource{}
+}
+
+// ReviewPolicyResource defines the resource implementation.
+type ReviewPolicyResource struct {
+	graphqlClient client.GraphQLClient
+}
+
+// ReviewPolicyResourceModel describes the resource data model.
+type ReviewPolicyResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	ReviewerTeamId    types.String   `tfsdk:"reviewer_team_id"`
+	AllowSelfApproval types.Bool     `tfsdk:"allow_self_approval"`
+	ReadOnlyBypass    types.Bool     `tfsdk:"read_only_bypass"`
+	ReviewerCount     types.Int64    `tfsdk:"reviewer_count"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ReviewPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_review_policy"
+}
+
+func (r *ReviewPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Who may review queries run with a `querydesk_database_user`, referenced by its `review_policy_id`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the policy.",
+				Required:            true,
+			},
+			"reviewer_team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team whose members may review queries. Any user may review when not set.",
+				Optional:            true,
+			},
+			"allow_self_approval": schema.BoolAttribute{
+				MarkdownDescription: "Whether the author of a query can approve it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"read_only_bypass": schema.BoolAttribute{
+				MarkdownDescription: "Whether read only statements such as `SELECT` can run without review. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"reviewer_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of users who can review queries under this policy.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ReviewPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.graphqlClient = data.client
+}
+
+func (r *ReviewPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ReviewPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	input := client.CreateReviewPolicyInput{
+		Name:              data.Name.ValueString(),
+		ReviewerTeamId:    data.ReviewerTeamId.ValueString(),
+		AllowSelfApproval: data.AllowSelfApproval.ValueBool(),
+		ReadOnlyBypass:    data.ReadOnlyBypass.ValueBool(),
+	}
+
+	graphqlResp, err := r.graphqlClient.CreateReviewPolicy(ctx, input)
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "creating review policy", createTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error creating review policy", err, reviewPolicyInputAttributes) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating review policy",
+			"Could not create review policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(graphqlResp.CreateReviewPolicy.Result.Id)
+	data.ReviewerCount = types.Int64Value(int64(graphqlResp.CreateReviewPolicy.Result.ReviewerCount))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ReviewPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	graphqlResp, err := r.graphqlClient.GetReviewPolicy(ctx, data.Id.ValueString())
+
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "reading review policy", readTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			err.Error(),
+		)
+		return
+	}
+
+	// If id is empty, the resource no longer exists
+	if graphqlResp.ReviewPolicy.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(graphqlResp.ReviewPolicy.Name)
+	data.ReviewerTeamId = types.StringNull()
+	if graphqlResp.ReviewPolicy.ReviewerTeamId != "" {
+		data.ReviewerTeamId = types.StringValue(graphqlResp.ReviewPolicy.ReviewerTeamId)
+	}
+	data.AllowSelfApproval = types.BoolValue(graphqlResp.ReviewPolicy.AllowSelfApproval)
+	data.ReadOnlyBypass = types.BoolValue(graphqlResp.ReviewPolicy.ReadOnlyBypass)
+	data.ReviewerCount = types.Int64Value(int64(graphqlResp.ReviewPolicy.ReviewerCount))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ReviewPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	input := client.UpdateReviewPolicyInput{
+		Name:              data.Name.ValueString(),
+		ReviewerTeamId:    data.ReviewerTeamId.ValueStringPointer(),
+		AllowSelfApproval: data.AllowSelfApproval.ValueBool(),
+		ReadOnlyBypass:    data.ReadOnlyBypass.ValueBool(),
+	}
+
+	graphqlResp, err := r.graphqlClient.UpdateReviewPolicy(ctx, data.Id.ValueString(), input)
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "updating review policy", updateTimeout) {
+			return
+		}
+
+		if addValidationError(&resp.Diagnostics, "Error updating review policy", err, reviewPolicyInputAttributes) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error updating review policy",
+			"Could not update review policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ReviewerCount = types.Int64Value(int64(graphqlResp.UpdateReviewPolicy.Result.ReviewerCount))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ReviewPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.graphqlClient.DeleteReviewPolicy(ctx, data.Id.ValueString())
+
+	// Already deleted outside of Terraform
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+
+	if err != nil {
+		if addTimeoutError(&resp.Diagnostics, err, "deleting review policy", deleteTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete review policy, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *ReviewPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkReviewerPool checks that the review policy has enough reviewers to
+// ever approve a query needing reviewsRequired reviews. Unknown values are
+// skipped, to be checked once they are known.
+func checkReviewerPool(ctx context.Context, c client.GraphQLClient, reviewPolicyId types.String, reviewsRequired types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if reviewPolicyId.IsNull() || reviewPolicyId.IsUnknown() || reviewsRequired.IsNull() || reviewsRequired.IsUnknown() {
+		return diags
+	}
+
+	graphqlResp, err := c.GetReviewPolicy(ctx, reviewPolicyId.ValueString())
+
+	if errors.Is(err, client.ErrNotFound) {
+		diags.AddAttributeError(
+			path.Root("review_policy_id"),
+			"Review Policy Not Found",
+			fmt.Sprintf("No review policy with id %s exists.", reviewPolicyId.String()),
+		)
+		return diags
+	}
+
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check Review Policy",
+			"Could not read the review policy to check reviews_required against its reviewers, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if reviewers := int64(graphqlResp.ReviewPolicy.ReviewerCount); reviewsRequired.ValueInt64() > reviewers {
+		diags.AddAttributeError(
+			path.Root("reviews_required"),
+			"Too Many Reviews Required",
+			fmt.Sprintf("%d reviews are required, but review policy %s only has %d reviewers, so queries could never be approved.",
+				reviewsRequired.ValueInt64(), graphqlResp.ReviewPolicy.Name, reviewers),
+		)
+	}
+
+	return diags
+}
+
+type reviewsRequiredValidator struct{}
+
+func (v reviewsRequiredValidator) Description(ctx context.Context) string {
+	return "value must not be negative"
+}
+
+func (v reviewsRequiredValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v reviewsRequiredValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if reviews := req.ConfigValue.ValueInt64(); reviews < 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Reviews Required", fmt.Sprintf("Expected 0 or more reviews, got: %d.", reviews))
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccReviewPolicyResource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const policyId = "policy_12345"
+
+	mockClient.EXPECT().CreateReviewPolicy(
+		mock.Anything,
+		client.CreateReviewPolicyInput{
+			Name:           "production",
+			ReviewerTeamId: "team_12345",
+			ReadOnlyBypass: true,
+		},
+	).Return(&client.CreateReviewPolicyResponse{
+		CreateReviewPolicy: client.CreateReviewPolicyCreateReviewPolicyCreateReviewPolicyResult{
+			Result: client.CreateReviewPolicyCreateReviewPolicyCreateReviewPolicyResultResultReviewPolicy{
+				Id:            policyId,
+				ReviewerCount: 3,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetReviewPolicy(
+		mock.Anything,
+		policyId,
+	).Return(&client.GetReviewPolicyResponse{
+		ReviewPolicy: client.GetReviewPolicyReviewPolicy{
+			Id:             policyId,
+			Name:           "production",
+			ReviewerTeamId: "team_12345",
+			ReadOnlyBypass: true,
+			ReviewerCount:  3,
+		},
+	}, nil).Times(3)
+
+	mockClient.EXPECT().UpdateReviewPolicy(
+		mock.Anything,
+		policyId,
+		client.UpdateReviewPolicyInput{
+			Name:           "production",
+			ReviewerTeamId: nil,
+			ReadOnlyBypass: true,
+		},
+	).Return(&client.UpdateReviewPolicyResponse{
+		UpdateReviewPolicy: client.UpdateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResult{
+			Result: client.UpdateReviewPolicyUpdateReviewPolicyUpdateReviewPolicyResultResultReviewPolicy{
+				Id:            policyId,
+				ReviewerCount: 10,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetReviewPolicy(
+		mock.Anything,
+		policyId,
+	).Return(&client.GetReviewPolicyResponse{
+		ReviewPolicy: client.GetReviewPolicyReviewPolicy{
+			Id:             policyId,
+			Name:           "production",
+			ReadOnlyBypass: true,
+			ReviewerCount:  10,
+		},
+	}, nil)
+
+	mockClient.EXPECT().DeleteReviewPolicy(
+		mock.Anything,
+		policyId,
+	).Return(&client.DeleteReviewPolicyResponse{
+		DeleteReviewPolicy: client.DeleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResult{
+			Result: client.DeleteReviewPolicyDeleteReviewPolicyDeleteReviewPolicyResultResultReviewPolicy{
+				Id: policyId,
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccReviewPolicyResourceConfig(`reviewer_team_id = "team_12345"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_review_policy.test", "id", policyId),
+					resource.TestCheckResourceAttr("querydesk_review_policy.test", "allow_self_approval", "false"),
+					resource.TestCheckResourceAttr("querydesk_review_policy.test", "reviewer_count", "3"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "querydesk_review_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccReviewPolicyResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("querydesk_review_policy.test", "reviewer_team_id"),
+					resource.TestCheckResourceAttr("querydesk_review_policy.test", "reviewer_count", "10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccReviewPolicyResourceConfig(reviewerTeam string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "querydesk_review_policy" "test" {
+  name             = "production"
+  read_only_bypass = true
+  %s
+}
+`, reviewerTeam)
+}
+
+func TestCheckReviewerPool(t *testing.T) {
+	tests := map[string]struct {
+		reviewsRequired types.Int64
+		resp            *client.GetReviewPolicyResponse
+		err             error
+		wantSummary     string
+	}{
+		"enough reviewers": {
+			reviewsRequired: types.Int64Value(2),
+			resp:            &client.GetReviewPolicyResponse{ReviewPolicy: client.GetReviewPolicyReviewPolicy{Name: "production", ReviewerCount: 2}},
+		},
+		"too many reviews": {
+			reviewsRequired: types.Int64Value(3),
+			resp:            &client.GetReviewPolicyResponse{ReviewPolicy: client.GetReviewPolicyReviewPolicy{Name: "production", ReviewerCount: 2}},
+			wantSummary:     "Too Many Reviews Required",
+		},
+		"policy deleted": {
+			reviewsRequired: types.Int64Value(1),
+			err:             fmt.Errorf("%w (request id: 1)", client.ErrNotFound),
+			wantSummary:     "Review Policy Not Found",
+		},
+		"api error": {
+			reviewsRequired: types.Int64Value(1),
+			err:             errors.New("connection refused"),
+			wantSummary:     "Unable to Check Review Policy",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+			mockClient.EXPECT().GetReviewPolicy(mock.Anything, "policy_12345").Return(test.resp, test.err)
+
+			diags := checkReviewerPool(context.Background(), mockClient, types.StringValue("policy_12345"), test.reviewsRequired)
+
+			if test.wantSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, test.wantSummary, diags[0].Summary())
+			}
+		})
+	}
+
+	// Unknown values are checked once they are known, without calling the API.
+	assert.Empty(t, checkReviewerPool(context.Background(), client.NewMockGraphQLClient(t), types.StringUnknown(), types.Int64Value(5)))
+	assert.Empty(t, checkReviewerPool(context.Background(), client.NewMockGraphQLClient(t), types.StringNull(), types.Int64Value(5)))
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccTeamMembershipResource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const membershipId = "membership_12345"
+
+	mockClient.EXPECT().CreateTeamMembership(
+		mock.Anything,
+		client.CreateTeamMembershipInput{
+			TeamId: "team_12345",
+			UserId: "user_12345",
+		},
+	).Return(&client.CreateTeamMembershipResponse{
+		CreateTeamMembership: client.CreateTeamMembershipCreateTeamMembershipCreateTeamMembershipResult{
+			Result: client.CreateTeamMembershipCreateTeamMembershipCreateTeamMembershipResultResultTeamMembership{
+				Id: membershipId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetTeamMembership(
+		mock.Anything,
+		membershipId,
+	).Return(&client.GetTeamMembershipResponse{
+		TeamMembership: client.GetTeamMembershipTeamMembership{
+			Id:     membershipId,
+			TeamId: "team_12345",
+			UserId: "user_12345",
+		},
+	}, nil).Times(3)
+
+	// Removed in the UI
+	mockClient.EXPECT().GetTeamMembership(
+		mock.Anything,
+		membershipId,
+	).Return(nil, fmt.Errorf("%w (request id: 1)", client.ErrNotFound)).Once()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembershipResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_team_membership.test", "id", membershipId),
+					resource.TestCheckResourceAttr("querydesk_team_membership.test", "team_id", "team_12345"),
+					resource.TestCheckResourceAttr("querydesk_team_membership.test", "user_id", "user_12345"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "querydesk_team_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removed memberships are planned to be created again
+			{
+				Config:             testAccTeamMembershipResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+const testAccTeamMembershipResourceConfig = providerConfig + `
+resource "querydesk_team_membership" "test" {
+  team_id = "team_12345"
+  user_id = "user_12345"
+}
+`
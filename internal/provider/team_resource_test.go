@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const teamId = "team_12345"
+
+	mockClient.EXPECT().CreateTeam(
+		mock.Anything,
+		client.CreateTeamInput{
+			Name:        "platform",
+			Description: "Platform engineers",
+		},
+	).Return(&client.CreateTeamResponse{
+		CreateTeam: client.CreateTeamCreateTeamCreateTeamResult{
+			Result: client.CreateTeamCreateTeamCreateTeamResultResultTeam{
+				Id: teamId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetTeam(
+		mock.Anything,
+		teamId,
+	).Return(&client.GetTeamResponse{
+		Team: client.GetTeamTeam{
+			Id:          teamId,
+			Name:        "platform",
+			Description: "Platform engineers",
+		},
+	}, nil).Times(3)
+
+	mockClient.EXPECT().UpdateTeam(
+		mock.Anything,
+		teamId,
+		client.UpdateTeamInput{
+			Name:        "infrastructure",
+			Description: "Platform engineers",
+		},
+	).Return(&client.UpdateTeamResponse{
+		UpdateTeam: client.UpdateTeamUpdateTeamUpdateTeamResult{
+			Result: client.UpdateTeamUpdateTeamUpdateTeamResultResultTeam{
+				Id: teamId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetTeam(
+		mock.Anything,
+		teamId,
+	).Return(&client.GetTeamResponse{
+		Team: client.GetTeamTeam{
+			Id:          teamId,
+			Name:        "infrastructure",
+			Description: "Platform engineers",
+		},
+	}, nil)
+
+	mockClient.EXPECT().DeleteTeam(
+		mock.Anything,
+		teamId,
+	).Return(&client.DeleteTeamResponse{
+		DeleteTeam: client.DeleteTeamDeleteTeamDeleteTeamResult{
+			Result: client.DeleteTeamDeleteTeamDeleteTeamResultResultTeam{
+				Id: teamId,
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamResourceConfig("platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_team.test", "id", teamId),
+					resource.TestCheckResourceAttr("querydesk_team.test", "description", "Platform engineers"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "querydesk_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamResourceConfig("infrastructure"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_team.test", "name", "infrastructure"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamResourceConfig(name string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "querydesk_team" "test" {
+  name        = %q
+  description = "Platform engineers"
+}
+`, name)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOperationTimeout is used for resource operations without a value in
+// the timeouts block.
+const defaultOperationTimeout = 5 * time.Minute
+
+// nullTimeouts returns an unset timeouts block, e.g. for state upgraded from a
+// schema version without one.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// addTimeoutError adds a diagnostic if err was caused by the operation running
+// past its deadline, returning true when it did so. action describes the
+// operation, e.g. "creating database".
+func addTimeoutError(diags *diag.Diagnostics, err error, action string, timeout time.Duration) bool {
+	var netErr net.Error
+	if !errors.Is(err, context.DeadlineExceeded) && !(errors.As(err, &netErr) && netErr.Timeout()) {
+		return false
+	}
+
+	diags.AddError(
+		"Timed out "+action,
+		fmt.Sprintf("The QueryDesk API did not respond in time while %s (operation timeout %s). "+
+			"If the API is slow rather than unavailable, increase the matching value in the resource's timeouts block "+
+			"or the provider's request_timeout.\n\nError: %s", action, timeout, err),
+	)
+
+	return true
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeoutNetError is a net.Error, such as from a request running past the
+// client's request_timeout.
+type timeoutNetError struct{}
+
+func (timeoutNetError) Error() string   { return "i/o timeout" }
+func (timeoutNetError) Timeout() bool   { return true }
+func (timeoutNetError) Temporary() bool { return false }
+
+var _ net.Error = timeoutNetError{}
+
+func TestAddTimeoutError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"deadline exceeded": {
+			err:  context.DeadlineExceeded,
+			want: true,
+		},
+		"wrapped deadline exceeded": {
+			err:  fmt.Errorf("Post \"http://localhost:4000/graphql\": %w", context.DeadlineExceeded),
+			want: true,
+		},
+		"network timeout": {
+			err:  &net.OpError{Op: "read", Net: "tcp", Err: timeoutNetError{}},
+			want: true,
+		},
+		"canceled": {
+			err:  context.Canceled,
+			want: false,
+		},
+		"other error": {
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			added := addTimeoutError(&diags, test.err, "creating database", 2*time.Minute)
+
+			assert.Equal(t, test.want, added)
+
+			if !test.want {
+				assert.Empty(t, diags)
+				return
+			}
+
+			require.Len(t, diags, 1)
+			assert.Equal(t, "Timed out creating database", diags[0].Summary())
+			assert.Contains(t, diags[0].Detail(), "(operation timeout 2m0s)")
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"terraform-provider-querydesk/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDatabaseUpdatedAt plans updated_at as unknown when Update will send
+// changes to QueryDesk, which then returns a new timestamp. Otherwise the
+// prior value kept by UseStateForUnknown is left in the plan.
+func planDatabaseUpdatedAt(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *DatabaseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	diags.Append(req.State.Get(ctx, &state)...)
+
+	// A plan that cannot be read yet, e.g. a block of unknown values, is
+	// treated as a change.
+	if diags.HasError() || !reflect.DeepEqual(databaseUpdateInput(plan, state, client.DatabaseAdapter(plan.Adapter.ValueString())), client.UpdateDatabaseInput{}) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+	}
+}
+
+// planCredentialUpdatedAt is planDatabaseUpdatedAt for database users.
+func planCredentialUpdatedAt(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *DatabaseUserResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	diags.Append(req.State.Get(ctx, &state)...)
+
+	if diags.HasError() || !reflect.DeepEqual(credentialUpdateInput(plan, state), client.UpdateCredentialInput{}) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+	}
+}
+
+// checkDatabaseUnchanged checks that the database was not changed outside of
+// Terraform since updatedAt was read, so that Update and Delete do not
+// silently overwrite those changes. State without updated_at, such as from
+// before it was tracked, is not checked.
+func checkDatabaseUnchanged(ctx context.Context, c client.GraphQLClient, id string, updatedAt types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if updatedAt.ValueString() == "" {
+		return diags
+	}
+
+	graphqlResp, err := c.GetDatabase(ctx, id)
+
+	// Left to the mutation, which handles databases deleted outside of Terraform
+	if errors.Is(err, client.ErrNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Check Database",
+			"Could not read the database to check it was not changed since the plan, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	addChangedSincePlanError(&diags, "database", updatedAt, graphqlResp.Database.UpdatedAt)
+
+	return diags
+}
+
+// checkCredentialUnchanged is checkDatabaseUnchanged for database users.
+func checkCredentialUnchanged(ctx context.Context, c client.GraphQLClient, id string, updatedAt types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if updatedAt.ValueString() == "" {
+		return diags
+	}
+
+	graphqlResp, err := c.GetCredential(ctx, id)
+
+	// Left to the mutation, which handles users deleted outside of Terraform
+	if errors.Is(err, client.ErrNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Check Database User",
+			"Could not read the database user to check it was not changed since the plan, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	addChangedSincePlanError(&diags, "database user", updatedAt, graphqlResp.Credential.UpdatedAt)
+
+	return diags
+}
+
+func addChangedSincePlanError(diags *diag.Diagnostics, resourceType string, prior types.String, current string) {
+	if current == prior.ValueString() {
+		return
+	}
+
+	diags.AddError(
+		"Resource Changed Since Plan",
+		fmt.Sprintf("The %s was changed outside of Terraform at %s, after the plan was made from its state at %s. "+
+			"Applying would overwrite those changes, so run terraform plan again to review them first.", resourceType, current, prior.ValueString()),
+	)
+}
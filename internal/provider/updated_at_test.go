@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDatabaseUnchanged(t *testing.T) {
+	const planned = "2024-07-01T09:00:00Z"
+
+	tests := map[string]struct {
+		resp        *client.GetDatabaseResponse
+		err         error
+		wantSummary string
+	}{
+		"unchanged": {
+			resp: &client.GetDatabaseResponse{Database: client.GetDatabaseDatabase{Id: "db_12345", UpdatedAt: planned}},
+		},
+		"changed in the UI": {
+			resp:        &client.GetDatabaseResponse{Database: client.GetDatabaseDatabase{Id: "db_12345", UpdatedAt: "2024-07-01T10:30:00Z"}},
+			wantSummary: "Resource Changed Since Plan",
+		},
+		"deleted": {
+			err: fmt.Errorf("%w (request id: 1)", client.ErrNotFound),
+		},
+		"api error": {
+			err:         errors.New("connection refused"),
+			wantSummary: "Unable to Check Database",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := client.NewMockGraphQLClient(t)
+			mockClient.EXPECT().GetDatabase(mock.Anything, "db_12345").Return(test.resp, test.err)
+
+			diags := checkDatabaseUnchanged(context.Background(), mockClient, "db_12345", types.StringValue(planned))
+
+			if test.wantSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, test.wantSummary, diags[0].Summary())
+			}
+		})
+	}
+
+	// State from before updated_at was tracked is not checked.
+	assert.Empty(t, checkDatabaseUnchanged(context.Background(), client.NewMockGraphQLClient(t), "db_12345", types.StringNull()))
+}
+
+func TestCheckCredentialUnchanged(t *testing.T) {
+	const planned = "2024-07-01T09:00:00Z"
+
+	mockClient := client.NewMockGraphQLClient(t)
+	mockClient.EXPECT().GetCredential(mock.Anything, "crd_12345").Return(&client.GetCredentialResponse{
+		Credential: client.GetCredentialCredential{Id: "crd_12345", UpdatedAt: "2024-07-01T10:30:00Z"},
+	}, nil)
+
+	diags := checkCredentialUnchanged(context.Background(), mockClient, "crd_12345", types.StringValue(planned))
+
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "Resource Changed Since Plan", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "database user was changed outside of Terraform at 2024-07-01T10:30:00Z")
+	}
+
+	assert.Empty(t, checkCredentialUnchanged(context.Background(), client.NewMockGraphQLClient(t), "crd_12345", types.StringNull()))
+}
+
+func TestPlanDatabaseUpdatedAt(t *testing.T) {
+	const updatedAt = "2024-07-01T09:00:00Z"
+
+	tests := map[string]struct {
+		change      map[string]tftypes.Value
+		wantUnknown bool
+	}{
+		"unchanged": {},
+		"kept in terraform": {
+			change: map[string]tftypes.Value{"deletion_protection": tftypes.NewValue(tftypes.Bool, true)},
+		},
+		"sent to querydesk": {
+			change:      map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "billing")},
+			wantUnknown: true,
+		},
+		"unknown": {
+			change:      map[string]tftypes.Value{"hostname": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+			wantUnknown: true,
+		},
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	NewDatabaseResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	value := func(change map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "db_12345"),
+			"name":                tftypes.NewValue(tftypes.String, "payments"),
+			"adapter":             tftypes.NewValue(tftypes.String, "POSTGRES"),
+			"hostname":            tftypes.NewValue(tftypes.String, "db.internal"),
+			"port":                tftypes.NewValue(tftypes.Number, 5432),
+			"database":            tftypes.NewValue(tftypes.String, "payments"),
+			"ssl":                 tftypes.NewValue(tftypes.Bool, false),
+			"restrict_access":     tftypes.NewValue(tftypes.Bool, true),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+			"force_delete":        tftypes.NewValue(tftypes.Bool, false),
+			"updated_at":          tftypes.NewValue(tftypes.String, updatedAt),
+		}
+		for name, v := range change {
+			values[name] = v
+		}
+
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			if v, ok := values[name]; ok {
+				attributes[name] = v
+			} else {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+		}
+
+		return tftypes.NewValue(objectType, attributes)
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(test.change)}
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: value(nil)},
+				Plan:  plan,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			planDatabaseUpdatedAt(context.Background(), req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var planned types.String
+			require.False(t, resp.Plan.GetAttribute(context.Background(), path.Root("updated_at"), &planned).HasError())
+
+			if test.wantUnknown {
+				assert.True(t, planned.IsUnknown())
+			} else {
+				assert.Equal(t, types.StringValue(updatedAt), planned)
+			}
+		})
+	}
+}
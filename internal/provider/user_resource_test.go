@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-querydesk/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccUserResource(t *testing.T) {
+	mockClient := client.NewMockGraphQLClient(t)
+
+	const userId = "user_12345"
+
+	mockClient.EXPECT().CreateUser(
+		mock.Anything,
+		client.CreateUserInput{
+			Email: "jane@example.com",
+			Name:  "Jane",
+			Role:  client.UserRoleUser,
+		},
+	).Return(&client.CreateUserResponse{
+		CreateUser: client.CreateUserCreateUserCreateUserResult{
+			Result: client.CreateUserCreateUserCreateUserResultResultUser{
+				Id: userId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetUser(
+		mock.Anything,
+		userId,
+	).Return(&client.GetUserResponse{
+		User: client.GetUserUser{
+			Id:    userId,
+			Email: "jane@example.com",
+			Name:  "Jane",
+			Role:  client.UserRoleUser,
+		},
+	}, nil).Times(3)
+
+	mockClient.EXPECT().UpdateUser(
+		mock.Anything,
+		userId,
+		client.UpdateUserInput{
+			Name: "Jane",
+			Role: client.UserRoleAdmin,
+		},
+	).Return(&client.UpdateUserResponse{
+		UpdateUser: client.UpdateUserUpdateUserUpdateUserResult{
+			Result: client.UpdateUserUpdateUserUpdateUserResultResultUser{
+				Id: userId,
+			},
+		},
+	}, nil)
+
+	mockClient.EXPECT().GetUser(
+		mock.Anything,
+		userId,
+	).Return(&client.GetUserResponse{
+		User: client.GetUserUser{
+			Id:    userId,
+			Email: "jane@example.com",
+			Name:  "Jane",
+			Role:  client.UserRoleAdmin,
+		},
+	}, nil)
+
+	mockClient.EXPECT().DeleteUser(
+		mock.Anything,
+		userId,
+	).Return(&client.DeleteUserResponse{
+		DeleteUser: client.DeleteUserDeleteUserDeleteUserResult{
+			Result: client.DeleteUserDeleteUserDeleteUserResultResultUser{
+				Id: userId,
+			},
+		},
+	}, nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(mockClient),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_user.test", "id", userId),
+					resource.TestCheckResourceAttr("querydesk_user.test", "role", "USER"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "querydesk_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserResourceConfig(`role = "ADMIN"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("querydesk_user.test", "role", "ADMIN"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserResourceConfig(role string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "querydesk_user" "test" {
+  email = "jane@example.com"
+  name  = "Jane"
+  %s
+}
+`, role)
+}
@@ -6,10 +6,15 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
+	"terraform-provider-querydesk/internal/client"
+	"terraform-provider-querydesk/internal/export"
 	"terraform-provider-querydesk/internal/provider"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -33,6 +38,25 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		var run func([]string) error
+
+		switch os.Args[1] {
+		case "export":
+			run = runExport
+		case "schema-check":
+			run = runSchemaCheck
+		}
+
+		if run != nil {
+			if err := run(os.Args[2:]); err != nil {
+				log.Fatal(err.Error())
+			}
+
+			return
+		}
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -50,3 +74,84 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// apiFlags adds the -host and -api-key flags shared by the subcommands to
+// flags, and returns a function creating a client from them once parsed.
+func apiFlags(flags *flag.FlagSet) func() (*graphql.Client, error) {
+	var host, apiKey string
+
+	flags.StringVar(&host, "host", os.Getenv("QUERYDESK_HOST"), "QueryDesk host, defaults to $QUERYDESK_HOST")
+	flags.StringVar(&apiKey, "api-key", os.Getenv("QUERYDESK_API_KEY"), "QueryDesk API key, defaults to $QUERYDESK_API_KEY")
+
+	return func() (*graphql.Client, error) {
+		if host == "" || apiKey == "" {
+			return nil, fmt.Errorf("a host and api key are required, set -host and -api-key or QUERYDESK_HOST and QUERYDESK_API_KEY")
+		}
+
+		return client.NewClient(&host, &apiKey, client.ClientOptions{
+			UserAgent: fmt.Sprintf("terraform-provider-querydesk/%s %s", version, flags.Name()),
+		})
+	}
+}
+
+// runExport writes Terraform configuration for the databases and credentials
+// in an existing QueryDesk account, e.g.
+//
+//	terraform-provider-querydesk export -out ./querydesk
+func runExport(args []string) error {
+	var out string
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	newClient := apiFlags(flags)
+	flags.StringVar(&out, "out", ".", "directory to write the generated .tf files to")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	graphqlClient, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := export.Export(context.Background(), client.GraphQLReq{Client: *graphqlClient}, out); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote Terraform configuration to %s. Set the password variables, then run terraform plan to import.\n", out)
+
+	return nil
+}
+
+// runSchemaCheck compares the live QueryDesk API schema with the one the
+// provider was built against, exiting with an error if they are incompatible.
+func runSchemaCheck(args []string) error {
+	flags := flag.NewFlagSet("schema-check", flag.ExitOnError)
+	newClient := apiFlags(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	graphqlClient, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	problems, err := client.CheckSchema(context.Background(), *graphqlClient)
+	if err != nil {
+		return err
+	}
+
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+
+		return fmt.Errorf("the QueryDesk API schema is not compatible with provider version %s", version)
+	}
+
+	fmt.Println("The QueryDesk API schema is compatible.")
+
+	return nil
+}